@@ -0,0 +1,318 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	spacesv1alpha1 "github.com/upbound/up-sdk-go/apis/spaces/v1alpha1"
+)
+
+var (
+	namespaceGroupKind    = schema.GroupKind{Kind: "Namespace"}
+	secretGroupKind       = schema.GroupKind{Kind: "Secret"}
+	controlPlaneGroupKind = schema.GroupKind{Group: spacesv1alpha1.Group, Kind: "ControlPlane"}
+	spaceBackupGroupKind  = schema.GroupKind{Group: Group, Kind: SpaceBackupKind}
+)
+
+// SpaceBackupSelectionReason explains why a SpaceBackup includes or excludes
+// an object.
+type SpaceBackupSelectionReason string
+
+// Reasons a SpaceBackup includes or excludes an object.
+const (
+	// SpaceBackupSelectionMatched means the object is selected by the match
+	// selector and not by the exclude selector.
+	SpaceBackupSelectionMatched SpaceBackupSelectionReason = "Matched"
+
+	// SpaceBackupSelectionGroupNotMatched means the group of the object is not
+	// selected by the match selector.
+	SpaceBackupSelectionGroupNotMatched SpaceBackupSelectionReason = "GroupNotMatched"
+
+	// SpaceBackupSelectionNotMatched means the object is not selected by the
+	// match selector of its kind.
+	SpaceBackupSelectionNotMatched SpaceBackupSelectionReason = "NotMatched"
+
+	// SpaceBackupSelectionGroupExcluded means the group of the object is
+	// selected by the exclude selector.
+	SpaceBackupSelectionGroupExcluded SpaceBackupSelectionReason = "GroupExcluded"
+
+	// SpaceBackupSelectionExcluded means the object is selected by the
+	// exclude selector of its kind.
+	SpaceBackupSelectionExcluded SpaceBackupSelectionReason = "Excluded"
+
+	// SpaceBackupSelectionSpaceBackup means the object is a SpaceBackup, which
+	// are never backed up.
+	SpaceBackupSelectionSpaceBackup SpaceBackupSelectionReason = "SpaceBackup"
+)
+
+// SpaceBackupDecision is the decision of a SpaceBackup to include or exclude
+// an object.
+// +kubebuilder:object:generate=false
+type SpaceBackupDecision struct {
+	// GroupKind of the object.
+	GroupKind schema.GroupKind
+
+	// Namespace of the object, i.e. its group. It is empty for groups and
+	// cluster scoped objects.
+	Namespace string
+
+	// Name of the object.
+	Name string
+
+	// Included is true if the object is backed up.
+	Included bool
+
+	// Reason for the decision.
+	Reason SpaceBackupSelectionReason
+
+	// Message explains the decision for humans.
+	Message string
+}
+
+// SpaceBackupFailureBudget is the number of control plane backups that may
+// fail without failing a SpaceBackup.
+// +kubebuilder:object:generate=false
+type SpaceBackupFailureBudget struct {
+	// ControlPlanes is the number of control planes the SpaceBackup includes.
+	ControlPlanes int
+
+	// Percent of the control planes that may fail.
+	Percent int
+
+	// AllowedFailures is the number of control planes that may fail, rounded
+	// down.
+	AllowedFailures int
+}
+
+// SpaceBackupPreview is the selection of objects a SpaceBackup backs up.
+// +kubebuilder:object:generate=false
+type SpaceBackupPreview struct {
+	// Included objects, in the order they were supplied.
+	Included []SpaceBackupDecision
+
+	// Excluded objects, in the order they were supplied.
+	Excluded []SpaceBackupDecision
+
+	// FailureBudget of the included control planes.
+	FailureBudget SpaceBackupFailureBudget
+}
+
+// Preview evaluates which of the supplied objects of a Space the backup
+// includes, and why. Namespaces are evaluated as groups. The kind of objects
+// is read from their apiVersion and kind, except for typed Namespaces,
+// Secrets and SpaceBackups, which may omit them.
+//
+// Without a match selector all groups, control planes and secrets are
+// included. Within a selector, omitted groups, control planes and secrets
+// select all of them. Extras are additions to these defaults: other objects
+// are only included if an extra of their kind selects them. Without an
+// exclude selector nothing but SpaceBackups is excluded.
+func (d *SpaceBackupDefinition) Preview(objs []client.Object) (*SpaceBackupPreview, error) {
+	groups := map[string]client.Object{}
+	kinds := make([]schema.GroupKind, len(objs))
+	for i, o := range objs {
+		gk, err := groupKindOf(o)
+		if err != nil {
+			return nil, err
+		}
+		kinds[i] = gk
+		if gk == namespaceGroupKind {
+			groups[o.GetName()] = o
+		}
+	}
+
+	p := &SpaceBackupPreview{}
+	for i, o := range objs {
+		dec, err := d.decide(kinds[i], o, groups)
+		if err != nil {
+			return nil, err
+		}
+		if !dec.Included {
+			p.Excluded = append(p.Excluded, dec)
+			continue
+		}
+		p.Included = append(p.Included, dec)
+		if dec.GroupKind == controlPlaneGroupKind {
+			p.FailureBudget.ControlPlanes++
+		}
+	}
+
+	pct, err := d.Failures.percent()
+	if err != nil {
+		return nil, err
+	}
+	p.FailureBudget.Percent = pct
+	p.FailureBudget.AllowedFailures = p.FailureBudget.ControlPlanes * pct / 100
+	return p, nil
+}
+
+func (d *SpaceBackupDefinition) decide(gk schema.GroupKind, o client.Object, groups map[string]client.Object) (SpaceBackupDecision, error) { //nolint:gocyclo // A switch over kinds.
+	dec := SpaceBackupDecision{GroupKind: gk, Namespace: o.GetNamespace(), Name: o.GetName()}
+	decided := func(included bool, r SpaceBackupSelectionReason, format string, args ...any) (SpaceBackupDecision, error) {
+		dec.Included, dec.Reason, dec.Message = included, r, fmt.Sprintf(format, args...)
+		return dec, nil
+	}
+	if gk == spaceBackupGroupKind {
+		return decided(false, SpaceBackupSelectionSpaceBackup, "SpaceBackups are never backed up")
+	}
+
+	match, exclude := d.Match, d.Exclude
+	if match == nil {
+		match = &SpaceBackupResourceSelector{}
+	}
+	if exclude == nil {
+		exclude = &SpaceBackupResourceSelector{}
+	}
+
+	group := o
+	if gk != namespaceGroupKind {
+		group = nil
+		if ns := o.GetNamespace(); ns != "" {
+			group = groups[ns]
+			if group == nil {
+				group = &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: ns}}
+			}
+		}
+	}
+	if group != nil {
+		ok, err := selects(match.Groups, group, true)
+		if err != nil {
+			return dec, err
+		}
+		if !ok {
+			return decided(false, SpaceBackupSelectionGroupNotMatched, "group %q is not selected by match.groups", group.GetName())
+		}
+		ok, err = selects(exclude.Groups, group, false)
+		if err != nil {
+			return dec, err
+		}
+		if ok {
+			return decided(false, SpaceBackupSelectionGroupExcluded, "group %q is selected by exclude.groups", group.GetName())
+		}
+	}
+	if gk == namespaceGroupKind {
+		return decided(true, SpaceBackupSelectionMatched, "group is selected by match.groups")
+	}
+
+	var (
+		field      string
+		matched    bool
+		excluded   bool
+		matchErr   error
+		excludeErr error
+	)
+	switch gk {
+	case controlPlaneGroupKind:
+		field = "controlPlanes"
+		matched, matchErr = selects(match.ControlPlanes, o, true)
+		excluded, excludeErr = selects(exclude.ControlPlanes, o, false)
+	case secretGroupKind:
+		field = "secrets"
+		matched, matchErr = selects(match.Secrets, o, true)
+		excluded, excludeErr = selects(exclude.Secrets, o, false)
+	default:
+		field = "extras"
+		matched, matchErr = selectsExtra(match.Extras, gk, o)
+		excluded, excludeErr = selectsExtra(exclude.Extras, gk, o)
+	}
+	if matchErr != nil {
+		return dec, matchErr
+	}
+	if excludeErr != nil {
+		return dec, excludeErr
+	}
+	switch {
+	case !matched:
+		return decided(false, SpaceBackupSelectionNotMatched, "not selected by match.%s", field)
+	case excluded:
+		return decided(false, SpaceBackupSelectionExcluded, "selected by exclude.%s", field)
+	default:
+		return decided(true, SpaceBackupSelectionMatched, "selected by match.%s", field)
+	}
+}
+
+// selects returns whether the selector selects the object, or dflt if there
+// is no selector.
+func selects(s *spacesv1alpha1.ResourceSelector, o client.Object, dflt bool) (bool, error) {
+	if s == nil {
+		return dflt, nil
+	}
+	ok, err := s.Matches(o)
+	if err != nil {
+		return false, fmt.Errorf("cannot evaluate selector for %s: %w", o.GetName(), err)
+	}
+	return ok, nil
+}
+
+// selectsExtra returns whether any of the extras of the object's kind selects
+// it.
+func selectsExtra(extras []GenericSpaceBackupResourceSelector, gk schema.GroupKind, o client.Object) (bool, error) {
+	for i := range extras {
+		e := &extras[i]
+		if e.APIGroup != gk.Group || e.Kind != gk.Kind {
+			continue
+		}
+		ok, err := selects(&e.ResourceSelector, o, false)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// groupKindOf returns the kind of the object.
+func groupKindOf(o client.Object) (schema.GroupKind, error) {
+	if gk := o.GetObjectKind().GroupVersionKind().GroupKind(); gk.Kind != "" {
+		return gk, nil
+	}
+	switch o.(type) {
+	case *corev1.Namespace:
+		return namespaceGroupKind, nil
+	case *corev1.Secret:
+		return secretGroupKind, nil
+	case *SpaceBackup:
+		return spaceBackupGroupKind, nil
+	}
+	return schema.GroupKind{}, fmt.Errorf("cannot determine the kind of object %q: apiVersion and kind must be set", o.GetName())
+}
+
+// percent returns the percentage of control planes that may fail. Integers
+// are percentages too. It defaults to zero.
+func (f SpaceBackupFailuresConfig) percent() (int, error) {
+	if f.ControlPlanes == nil {
+		return 0, nil
+	}
+	v := *f.ControlPlanes
+	pct := int(v.IntVal)
+	if v.Type == intstr.String {
+		n, err := intstr.GetScaledValueFromIntOrPercent(&v, 100, false)
+		if err != nil || !strings.HasSuffix(v.StrVal, "%") {
+			return 0, fmt.Errorf("invalid failures.controlPlanes %q: must be an integer or a percentage", v.StrVal)
+		}
+		pct = n
+	}
+	if pct < 0 || pct > 100 {
+		return 0, fmt.Errorf("invalid failures.controlPlanes %s: must be between 0 and 100 percent", v.String())
+	}
+	return pct, nil
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	spacesv1alpha1 "github.com/upbound/up-sdk-go/apis/spaces/v1alpha1"
+)
+
+func previewObject(apiVersion, kind, namespace, name string, labels map[string]string) client.Object {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	u.SetLabels(labels)
+	return u
+}
+
+func TestSpaceBackupPreview(t *testing.T) {
+	prod := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"env": "prod"}}}
+	dev := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev", Labels: map[string]string{"env": "dev"}}}
+	ctp1 := previewObject("spaces.upbound.io/v1beta1", "ControlPlane", "prod", "ctp1", nil)
+	ctp2 := previewObject("spaces.upbound.io/v1beta1", "ControlPlane", "prod", "ctp2", map[string]string{"backup": "skip"})
+	ctp3 := previewObject("spaces.upbound.io/v1beta1", "ControlPlane", "dev", "ctp3", nil)
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "creds"}}
+	config := previewObject("v1", "ConfigMap", "prod", "settings", nil)
+	account := previewObject("v1", "ServiceAccount", "prod", "settings", nil)
+	sb := &SpaceBackup{ObjectMeta: metav1.ObjectMeta{Name: "nightly"}}
+
+	decision := func(o client.Object, gk string, included bool, r SpaceBackupSelectionReason) SpaceBackupDecision {
+		d := SpaceBackupDecision{Namespace: o.GetNamespace(), Name: o.GetName(), Included: included, Reason: r}
+		switch gk {
+		case "Namespace":
+			d.GroupKind = namespaceGroupKind
+		case "Secret":
+			d.GroupKind = secretGroupKind
+		case "ControlPlane":
+			d.GroupKind = controlPlaneGroupKind
+		case "SpaceBackup":
+			d.GroupKind = spaceBackupGroupKind
+		default:
+			d.GroupKind.Kind = gk
+		}
+		return d
+	}
+	pct := func(s string) *intstr.IntOrString {
+		v := intstr.Parse(s)
+		return &v
+	}
+
+	type want struct {
+		preview *SpaceBackupPreview
+		err     bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		def    SpaceBackupDefinition
+		objs   []client.Object
+		want   want
+	}{
+		"Everything": {
+			reason: "Without selectors groups, control planes and secrets should be included, but no other objects.",
+			objs:   []client.Object{prod, ctp1, secret, config, sb},
+			want: want{preview: &SpaceBackupPreview{
+				Included: []SpaceBackupDecision{
+					decision(prod, "Namespace", true, SpaceBackupSelectionMatched),
+					decision(ctp1, "ControlPlane", true, SpaceBackupSelectionMatched),
+					decision(secret, "Secret", true, SpaceBackupSelectionMatched),
+				},
+				Excluded: []SpaceBackupDecision{
+					decision(config, "ConfigMap", false, SpaceBackupSelectionNotMatched),
+					decision(sb, "SpaceBackup", false, SpaceBackupSelectionSpaceBackup),
+				},
+				FailureBudget: SpaceBackupFailureBudget{ControlPlanes: 1},
+			}},
+		},
+		"MatchAndExclude": {
+			reason: "Objects should be included by group and kind, and exclusions should take precedence.",
+			def: SpaceBackupDefinition{
+				Match: &SpaceBackupResourceSelector{
+					Groups: &spacesv1alpha1.ResourceSelector{LabelSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"env": "prod"}}}},
+					Extras: []GenericSpaceBackupResourceSelector{{Kind: "ConfigMap", ResourceSelector: spacesv1alpha1.ResourceSelector{Names: []string{"other"}}}},
+				},
+				Exclude: &SpaceBackupResourceSelector{
+					ControlPlanes: &spacesv1alpha1.ResourceSelector{LabelSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"backup": "skip"}}}},
+				},
+				Failures: SpaceBackupFailuresConfig{ControlPlanes: pct("50%")},
+			},
+			objs: []client.Object{prod, dev, ctp1, ctp2, ctp3, config},
+			want: want{preview: &SpaceBackupPreview{
+				Included: []SpaceBackupDecision{
+					decision(prod, "Namespace", true, SpaceBackupSelectionMatched),
+					decision(ctp1, "ControlPlane", true, SpaceBackupSelectionMatched),
+				},
+				Excluded: []SpaceBackupDecision{
+					decision(dev, "Namespace", false, SpaceBackupSelectionGroupNotMatched),
+					decision(ctp2, "ControlPlane", false, SpaceBackupSelectionExcluded),
+					decision(ctp3, "ControlPlane", false, SpaceBackupSelectionGroupNotMatched),
+					decision(config, "ConfigMap", false, SpaceBackupSelectionNotMatched),
+				},
+				FailureBudget: SpaceBackupFailureBudget{ControlPlanes: 1, Percent: 50},
+			}},
+		},
+		"Extras": {
+			reason: "Other objects should be included only if an extra of their kind selects them.",
+			def: SpaceBackupDefinition{
+				Match: &SpaceBackupResourceSelector{
+					Extras: []GenericSpaceBackupResourceSelector{{Kind: "ConfigMap", ResourceSelector: spacesv1alpha1.ResourceSelector{Names: []string{"settings"}}}},
+				},
+			},
+			objs: []client.Object{config, account},
+			want: want{preview: &SpaceBackupPreview{
+				Included: []SpaceBackupDecision{
+					decision(config, "ConfigMap", true, SpaceBackupSelectionMatched),
+				},
+				Excluded: []SpaceBackupDecision{
+					decision(account, "ServiceAccount", false, SpaceBackupSelectionNotMatched),
+				},
+			}},
+		},
+		"GroupExcluded": {
+			reason: "Objects in excluded groups should be excluded, even if their namespace wasn't supplied.",
+			def: SpaceBackupDefinition{
+				Exclude: &SpaceBackupResourceSelector{Groups: &spacesv1alpha1.ResourceSelector{Names: []string{"dev"}}},
+			},
+			objs: []client.Object{ctp1, ctp3},
+			want: want{preview: &SpaceBackupPreview{
+				Included: []SpaceBackupDecision{
+					decision(ctp1, "ControlPlane", true, SpaceBackupSelectionMatched),
+				},
+				Excluded: []SpaceBackupDecision{
+					decision(ctp3, "ControlPlane", false, SpaceBackupSelectionGroupExcluded),
+				},
+				FailureBudget: SpaceBackupFailureBudget{ControlPlanes: 1},
+			}},
+		},
+		"FailureBudget": {
+			reason: "An integer failure budget should be a percentage of the selected control planes, rounded down.",
+			def:    SpaceBackupDefinition{Failures: SpaceBackupFailuresConfig{ControlPlanes: pct("50")}},
+			objs:   []client.Object{ctp1, ctp2, ctp3},
+			want: want{preview: &SpaceBackupPreview{
+				Included: []SpaceBackupDecision{
+					decision(ctp1, "ControlPlane", true, SpaceBackupSelectionMatched),
+					decision(ctp2, "ControlPlane", true, SpaceBackupSelectionMatched),
+					decision(ctp3, "ControlPlane", true, SpaceBackupSelectionMatched),
+				},
+				FailureBudget: SpaceBackupFailureBudget{ControlPlanes: 3, Percent: 50, AllowedFailures: 1},
+			}},
+		},
+		"InvalidFailureBudget": {
+			reason: "A failure budget above 100 percent should be an error.",
+			def:    SpaceBackupDefinition{Failures: SpaceBackupFailuresConfig{ControlPlanes: pct("150%")}},
+			objs:   []client.Object{ctp1},
+			want:   want{err: true},
+		},
+		"UnknownKind": {
+			reason: "Objects without a kind should be an error.",
+			objs:   []client.Object{&unstructured.Unstructured{}},
+			want:   want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.def.Preview(tc.objs)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\nPreview(...): want error %t, got %v", tc.reason, tc.want.err, err)
+			}
+			if diff := cmp.Diff(tc.want.preview, got, cmpopts.IgnoreFields(SpaceBackupDecision{}, "Message")); diff != "" {
+				t.Errorf("\n%s\nPreview(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
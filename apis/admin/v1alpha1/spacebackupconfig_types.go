@@ -18,6 +18,7 @@ import (
 	"reflect"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/upbound/up-sdk-go/apis/common"
 	spacesv1alpha1 "github.com/upbound/up-sdk-go/apis/spaces/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -58,8 +59,28 @@ type SpaceBackupConfigSpec struct {
 }
 
 // SpaceBackupObjectStorage specifies the object storage configuration for the given provider.
+// It has the same fields as spacesv1alpha1.BackupObjectStorage, but different
+// credentials.
 type SpaceBackupObjectStorage struct {
-	spacesv1alpha1.BackupObjectStorage `json:",inline"`
+	// Provider is the name of the object storage provider.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=AWS;Azure;GCP
+	Provider spacesv1alpha1.BackupObjectStorageProvider `json:"provider"`
+
+	// Bucket is the name of the bucket to store backups in.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+
+	// Prefix is the prefix to use for all backups of the Space.
+	Prefix string `json:"prefix,omitempty"`
+
+	// Config is a free-form map of configuration options for the object storage provider.
+	// See https://github.com/thanos-io/objstore?tab=readme-ov-file for more
+	// information on the formats for each supported cloud provider. Bucket and
+	// Provider will override the required values in the config.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Config common.JSONObject `json:"config,omitempty"`
 
 	// Credentials specifies the credentials to access the object storage.
 	// +kubebuilder:validation:Required
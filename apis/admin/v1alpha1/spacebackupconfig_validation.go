@@ -0,0 +1,94 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	spacesv1alpha1 "github.com/upbound/up-sdk-go/apis/spaces/v1alpha1"
+)
+
+// Validate returns the errors of the credentials. A Secret source requires a
+// secret reference including its namespace, which other sources don't
+// support. Environment and filesystem selectors are not supported.
+func (c *SpaceBackupCredentials) Validate(pth *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if c.Env != nil {
+		errs = append(errs, field.Forbidden(pth.Child("env"), "environment credentials are not supported"))
+	}
+	if c.Fs != nil {
+		errs = append(errs, field.Forbidden(pth.Child("fs"), "filesystem credentials are not supported"))
+	}
+	switch c.Source {
+	case xpv1.CredentialsSourceSecret:
+		if c.SecretRef == nil {
+			return append(errs, field.Required(pth.Child("secretRef"), "required when source is Secret"))
+		}
+		if c.SecretRef.Name == "" {
+			errs = append(errs, field.Required(pth.Child("secretRef", "name"), ""))
+		}
+		if c.SecretRef.Namespace == "" {
+			errs = append(errs, field.Required(pth.Child("secretRef", "namespace"), ""))
+		}
+	case xpv1.CredentialsSourceInjectedIdentity:
+		if c.SecretRef != nil {
+			errs = append(errs, field.Forbidden(pth.Child("secretRef"), "must not be set when source is InjectedIdentity"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(pth.Child("source"), c.Source, []xpv1.CredentialsSource{
+			xpv1.CredentialsSourceSecret,
+			xpv1.CredentialsSourceInjectedIdentity,
+		}))
+	}
+	return errs
+}
+
+// Validate returns the errors of the object storage.
+func (s *SpaceBackupObjectStorage) Validate(pth *field.Path) field.ErrorList {
+	return append(s.objectStorage().ValidateProvider(pth), s.Credentials.Validate(pth.Child("credentials"))...)
+}
+
+// AWSConfig decodes the config of the object storage as AWS config.
+func (s *SpaceBackupObjectStorage) AWSConfig() (*spacesv1alpha1.AWSObjectStorageConfig, error) {
+	return s.objectStorage().AWSConfig()
+}
+
+// AzureConfig decodes the config of the object storage as Azure config.
+func (s *SpaceBackupObjectStorage) AzureConfig() (*spacesv1alpha1.AzureObjectStorageConfig, error) {
+	return s.objectStorage().AzureConfig()
+}
+
+// GCPConfig decodes the config of the object storage as GCP config.
+func (s *SpaceBackupObjectStorage) GCPConfig() (*spacesv1alpha1.GCPObjectStorageConfig, error) {
+	return s.objectStorage().GCPConfig()
+}
+
+// objectStorage returns the object storage without its credentials, which
+// are validated separately.
+func (s *SpaceBackupObjectStorage) objectStorage() *spacesv1alpha1.BackupObjectStorage {
+	return &spacesv1alpha1.BackupObjectStorage{
+		Provider: s.Provider,
+		Bucket:   s.Bucket,
+		Prefix:   s.Prefix,
+		Config:   s.Config,
+	}
+}
+
+// Validate returns the errors of the SpaceBackupConfig.
+func (c *SpaceBackupConfig) Validate() field.ErrorList {
+	return c.Spec.ObjectStorage.Validate(field.NewPath("spec", "objectStorage"))
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	spacesv1alpha1 "github.com/upbound/up-sdk-go/apis/spaces/v1alpha1"
+)
+
+func TestSpaceBackupConfigValidate(t *testing.T) {
+	config := common.JSONObject{Object: map[string]interface{}{"region": "us-west-2"}}
+
+	cases := map[string]struct {
+		reason  string
+		storage SpaceBackupObjectStorage
+		want    []string
+	}{
+		"Valid": {
+			reason: "A secret reference including its namespace should be valid.",
+			storage: SpaceBackupObjectStorage{
+				Provider: spacesv1alpha1.BackupObjectStorageProviderAWS,
+				Bucket:   "backups",
+				Config:   config,
+				Credentials: SpaceBackupCredentials{
+					Source: xpv1.CredentialsSourceSecret,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "creds", Namespace: "upbound-system"},
+						Key:             "credentials",
+					}},
+				},
+			},
+		},
+		"SecretWithoutNamespace": {
+			reason: "A secret reference should require a namespace.",
+			storage: SpaceBackupObjectStorage{
+				Provider: spacesv1alpha1.BackupObjectStorageProviderAWS,
+				Bucket:   "backups",
+				Config:   config,
+				Credentials: SpaceBackupCredentials{
+					Source: xpv1.CredentialsSourceSecret,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "creds"},
+					}},
+				},
+			},
+			want: []string{"spec.objectStorage.credentials.secretRef.namespace: FieldValueRequired"},
+		},
+		"UnsupportedSelectors": {
+			reason: "Environment and filesystem selectors should be forbidden, and the bucket checked per provider.",
+			storage: SpaceBackupObjectStorage{
+				Provider: spacesv1alpha1.BackupObjectStorageProviderAWS,
+				Bucket:   "BACKUPS",
+				Config:   config,
+				Credentials: SpaceBackupCredentials{
+					Source: xpv1.CredentialsSourceInjectedIdentity,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+						Env: &xpv1.EnvSelector{Name: "CREDS"},
+						Fs:  &xpv1.FsSelector{Path: "/creds"},
+					},
+				},
+			},
+			want: []string{
+				"spec.objectStorage.bucket: FieldValueInvalid",
+				"spec.objectStorage.credentials.env: FieldValueForbidden",
+				"spec.objectStorage.credentials.fs: FieldValueForbidden",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &SpaceBackupConfig{Spec: SpaceBackupConfigSpec{ObjectStorage: tc.storage}}
+			var got []string
+			for _, e := range c.Validate() {
+				got = append(got, e.Field+": "+string(e.Type))
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -302,7 +302,7 @@ func (in *SpaceBackupList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SpaceBackupObjectStorage) DeepCopyInto(out *SpaceBackupObjectStorage) {
 	*out = *in
-	in.BackupObjectStorage.DeepCopyInto(&out.BackupObjectStorage)
+	in.Config.DeepCopyInto(&out.Config)
 	in.Credentials.DeepCopyInto(&out.Credentials)
 }
 
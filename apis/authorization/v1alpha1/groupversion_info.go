@@ -42,3 +42,8 @@ var (
 	//nolint:gochecknoglobals // This is an established pattern
 	AddToScheme = SchemeBuilder.AddToScheme
 )
+
+// GroupVersions returns the group/versions defined in this package.
+func GroupVersions() []schema.GroupVersion {
+	return []schema.GroupVersion{SchemeGroupVersion}
+}
@@ -15,16 +15,18 @@
 //go:build generate
 // +build generate
 
-// NOTE(negz): See the below link for details on what is happening here.
-// https://github.com/golang/go/wiki/Modules#how-can-i-track-tool-dependencies-for-a-module
+// The tools run below are tracked by the internal/tools package.
 
 // Replicate identical API versions.
 //go:generate ../hack/duplicate_api_type.sh spaces/v1beta1/backup_types.go spaces/v1alpha1 true
 //go:generate ../hack/duplicate_api_type.sh spaces/v1beta1/backupschedule_types.go spaces/v1alpha1 true
+//go:generate ../hack/duplicate_api_type.sh spaces/v1beta1/backup_retention.go spaces/v1alpha1
+//go:generate ../hack/duplicate_api_type.sh spaces/v1beta1/backupschedule_cron.go spaces/v1alpha1
 //go:generate ../hack/duplicate_api_type.sh spaces/v1beta1/resource_selector.go spaces/v1alpha1
 //go:generate ../hack/duplicate_api_type.sh spaces/v1beta1/sharedbackup_types.go spaces/v1alpha1 true
 //go:generate ../hack/duplicate_api_type.sh spaces/v1beta1/sharedbackupconfig_types.go spaces/v1alpha1 true
 //go:generate ../hack/duplicate_api_type.sh spaces/v1beta1/sharedbackupconfig_types.go spaces/v1alpha1 true
+//go:generate ../hack/duplicate_api_type.sh spaces/v1beta1/sharedbackupconfig_validation.go spaces/v1alpha1
 //go:generate ../hack/duplicate_api_type.sh spaces/v1beta1/sharedbackupschedule_types.go spaces/v1alpha1 true
 
 // Add license headers to all files.
@@ -33,14 +35,10 @@
 // Generate deepcopy methodsets and CRD manifests
 //go:generate go run -tags generate sigs.k8s.io/controller-tools/cmd/controller-gen object:headerFile=../hack/boilerplate.go.txt paths=./...
 
+// Generate conversions between API versions
+//go:generate go run -tags generate k8s.io/code-generator/cmd/conversion-gen --go-header-file=../hack/boilerplate.go.txt --output-file=zz_generated.conversion.go ./query/v1alpha1 ./spaces/v1alpha1
+
 // Generate crossplane-runtime methodsets (resource.Claim, etc)
 //go:generate go run -tags generate github.com/crossplane/crossplane-tools/cmd/angryjet generate-methodsets --header-file=../hack/boilerplate.go.txt ./...
 
 package generate
-
-import (
-	_ "github.com/google/addlicense"                    //nolint:typecheck
-	_ "sigs.k8s.io/controller-tools/cmd/controller-gen" //nolint:typecheck
-
-	_ "github.com/crossplane/crossplane-tools/cmd/angryjet" //nolint:typecheck
-)
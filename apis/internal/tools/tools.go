@@ -0,0 +1,30 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build generate
+// +build generate
+
+// Package tools tracks the tools apis/generate.go runs. They are imported here
+// rather than alongside the generate directives so that importing the apis
+// package doesn't add them to the module graph of its consumers.
+// https://github.com/golang/go/wiki/Modules#how-can-i-track-tool-dependencies-for-a-module
+package tools
+
+import (
+	_ "github.com/google/addlicense"                    //nolint:typecheck
+	_ "k8s.io/code-generator/cmd/conversion-gen"        //nolint:typecheck
+	_ "sigs.k8s.io/controller-tools/cmd/controller-gen" //nolint:typecheck
+
+	_ "github.com/crossplane/crossplane-tools/cmd/angryjet" //nolint:typecheck
+)
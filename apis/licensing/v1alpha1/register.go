@@ -38,6 +38,11 @@ var (
 	AddToScheme = SchemeBuilder.AddToScheme
 )
 
+// GroupVersions returns the group/versions defined in this package.
+func GroupVersions() []schema.GroupVersion {
+	return []schema.GroupVersion{SchemeGroupVersion}
+}
+
 // License type metadata.
 var (
 	LicenseKind             = "License"
@@ -44,6 +44,11 @@ var (
 	ControllerGroupVersionKind = SchemeGroupVersion.WithKind(ControllerKind)
 )
 
+// GroupVersions returns the group/versions defined in this package.
+func GroupVersions() []schema.GroupVersion {
+	return []schema.GroupVersion{SchemeGroupVersion}
+}
+
 func init() {
 	SchemeBuilder.Register(&Controller{})
 }
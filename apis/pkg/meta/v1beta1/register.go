@@ -44,6 +44,11 @@ var (
 	AddOnGroupVersionKind = SchemeGroupVersion.WithKind(AddOnKind)
 )
 
+// GroupVersions returns the group/versions defined in this package.
+func GroupVersions() []schema.GroupVersion {
+	return []schema.GroupVersion{SchemeGroupVersion}
+}
+
 func init() {
 	SchemeBuilder.Register(&AddOn{})
 }
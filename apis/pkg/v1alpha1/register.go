@@ -43,6 +43,11 @@ var (
 	AddToScheme = SchemeBuilder.AddToScheme
 )
 
+// GroupVersions returns the group/versions defined in this package.
+func GroupVersions() []schema.GroupVersion {
+	return []schema.GroupVersion{SchemeGroupVersion}
+}
+
 // RemoteConfiguration type metadata.
 var (
 	RemoteConfigurationKind                     = reflect.TypeOf(RemoteConfiguration{}).Name()
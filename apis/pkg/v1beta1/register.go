@@ -27,6 +27,11 @@ var (
 	AddToScheme = SchemeBuilder.AddToScheme
 )
 
+// GroupVersions returns the group/versions defined in this package.
+func GroupVersions() []schema.GroupVersion {
+	return []schema.GroupVersion{SchemeGroupVersion}
+}
+
 // AddOn type metadata.
 var (
 	AddOnKind                          = reflect.TypeOf(AddOn{}).Name()
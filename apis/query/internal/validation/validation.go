@@ -0,0 +1,106 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validation contains the validation shared by all versions of the
+// query.spaces.upbound.io API.
+package validation
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ConditionStatuses are the supported statuses of condition filters.
+var ConditionStatuses = sets.New("True", "False", "Unknown")
+
+const cursorAndFirstMessage = "must not be set together with cursor"
+
+// Page validates the limit and page of query resources.
+func Page(pth *field.Path, limit, first int, cursor string) field.ErrorList {
+	var errs field.ErrorList
+	if limit < 0 {
+		errs = append(errs, field.Invalid(pth.Child("limit"), limit, "must not be negative"))
+	}
+	if first < 0 {
+		errs = append(errs, field.Invalid(pth.Child("page", "first"), first, "must not be negative"))
+	}
+	if cursor != "" && first != 0 {
+		errs = append(errs, field.Invalid(pth.Child("page", "first"), first, cursorAndFirstMessage))
+	}
+	return errs
+}
+
+// An OrderField is a field of a query order and the direction to order it by.
+type OrderField[D ~string] struct {
+	// Name is the JSON name of the field.
+	Name string
+
+	// Direction is the direction to order by, or empty if the field is unset.
+	Direction D
+}
+
+// Order validates a query order. Exactly one of the supplied fields must be
+// set, to one of the valid directions.
+func Order[D ~string](pth *field.Path, valid sets.Set[D], fields ...OrderField[D]) field.ErrorList {
+	var errs field.ErrorList
+	set := 0
+	for _, f := range fields {
+		if f.Direction == "" {
+			continue
+		}
+		set++
+		if !valid.Has(f.Direction) {
+			errs = append(errs, field.NotSupported(pth.Child(f.Name), f.Direction, sets.List(valid)))
+		}
+	}
+	if set != 1 {
+		errs = append(errs, field.Invalid(pth, set, "exactly one field must be set"))
+	}
+	return errs
+}
+
+// Grouping validates the grouping of a query table, if it is set.
+func Grouping[G ~string](pth *field.Path, g G, valid sets.Set[G]) field.ErrorList {
+	if g == "" || valid.Has(g) {
+		return nil
+	}
+	return field.ErrorList{field.NotSupported(pth, g, sets.List(valid))}
+}
+
+// Skeleton validates a sparse skeleton of fields to return. Leaves must be
+// true.
+func Skeleton(pth *field.Path, v interface{}) field.ErrorList {
+	switch v := v.(type) {
+	case bool:
+		if !v {
+			return field.ErrorList{field.Invalid(pth, v, "must be true or an object")}
+		}
+		return nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var errs field.ErrorList
+		for _, k := range keys {
+			errs = append(errs, Skeleton(pth.Child(k), v[k])...)
+		}
+		return errs
+	default:
+		return field.ErrorList{field.Invalid(pth, v, "must be true or an object")}
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/conversion"
+
+	"github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+// The generated conversions cover all fields that are identical in both
+// versions. The functions below convert the filters, which were restructured
+// in v1alpha2. Fields that cannot be expressed in the other version are
+// reported as errors rather than silently dropped.
+
+var (
+	errMultipleFilters = errors.New("v1alpha1 supports only a single object filter, optionally restricted to a list of ids")
+	errMixedIDFilters  = errors.New("v1alpha1 cannot express object filters of which only some select an id")
+)
+
+// Convert_v1alpha1_QueryTopLevelFilter_To_v1alpha2_QueryTopLevelFilter
+// converts the v1alpha1 filter and its ids into one v1alpha2 object filter
+// per id, or a single object filter if no ids are set.
+func Convert_v1alpha1_QueryTopLevelFilter_To_v1alpha2_QueryTopLevelFilter(in *QueryTopLevelFilter, out *v1alpha2.QueryTopLevelFilter, s conversion.Scope) error { //nolint:revive,staticcheck // Conversion functions follow the generated naming scheme.
+	if err := autoConvert_v1alpha1_QueryTopLevelFilter_To_v1alpha2_QueryTopLevelFilter(in, out, s); err != nil {
+		return err
+	}
+	f := v1alpha2.QueryFilter{}
+	if err := Convert_v1alpha1_QueryFilter_To_v1alpha2_QueryFilter(&in.QueryFilter, &f, s); err != nil {
+		return err
+	}
+	if len(in.IDs) == 0 {
+		out.Objects = []v1alpha2.QueryFilter{f}
+		return nil
+	}
+	out.Objects = make([]v1alpha2.QueryFilter, len(in.IDs))
+	for i, id := range in.IDs {
+		f.DeepCopyInto(&out.Objects[i])
+		out.Objects[i].ID = id
+	}
+	return nil
+}
+
+// Convert_v1alpha2_QueryTopLevelFilter_To_v1alpha1_QueryTopLevelFilter
+// converts the v1alpha2 object filters into a single v1alpha1 filter. This is
+// only possible if there is one object filter, or if all object filters
+// select an id and are otherwise identical.
+func Convert_v1alpha2_QueryTopLevelFilter_To_v1alpha1_QueryTopLevelFilter(in *v1alpha2.QueryTopLevelFilter, out *QueryTopLevelFilter, s conversion.Scope) error { //nolint:revive,staticcheck // Conversion functions follow the generated naming scheme.
+	if err := autoConvert_v1alpha2_QueryTopLevelFilter_To_v1alpha1_QueryTopLevelFilter(in, out, s); err != nil {
+		return err
+	}
+	if len(in.Objects) == 0 {
+		return nil
+	}
+	ids := in.ObjectIDs()
+	if len(ids) > 0 && len(ids) != len(in.Objects) {
+		return errMixedIDFilters
+	}
+	f := in.Objects[0].DeepCopy()
+	f.ID = ""
+	for _, o := range in.Objects[1:] {
+		o := o.DeepCopy()
+		o.ID = ""
+		if len(ids) == 0 || !equality.Semantic.DeepEqual(f, o) {
+			return fmt.Errorf("cannot convert %d object filters: %w", len(in.Objects), errMultipleFilters)
+		}
+	}
+	if len(ids) > 0 {
+		out.IDs = ids
+	}
+	return Convert_v1alpha2_QueryFilter_To_v1alpha1_QueryFilter(f, &out.QueryFilter, s)
+}
+
+// Convert_v1alpha1_QueryFilter_To_v1alpha2_QueryFilter converts a v1alpha1
+// filter. Owners and SQL filters cannot be expressed in v1alpha2.
+func Convert_v1alpha1_QueryFilter_To_v1alpha2_QueryFilter(in *QueryFilter, out *v1alpha2.QueryFilter, s conversion.Scope) error { //nolint:revive,staticcheck // Conversion functions follow the generated naming scheme.
+	if len(in.Owners) > 0 {
+		return errors.New("v1alpha2 does not support filtering by owners")
+	}
+	if in.SQL != "" {
+		return errors.New("v1alpha2 does not support filtering by sql")
+	}
+	if err := autoConvert_v1alpha1_QueryFilter_To_v1alpha2_QueryFilter(in, out, s); err != nil {
+		return err
+	}
+	out.GroupKind = v1alpha2.QueryGroupKind{APIGroup: in.Group, Kind: in.Kind}
+	return nil
+}
+
+// Convert_v1alpha2_QueryFilter_To_v1alpha1_QueryFilter converts a v1alpha2
+// filter. Ids are only supported by top level filters, and creation
+// timestamp, label and JSONPath filters cannot be expressed in v1alpha1.
+func Convert_v1alpha2_QueryFilter_To_v1alpha1_QueryFilter(in *v1alpha2.QueryFilter, out *QueryFilter, s conversion.Scope) error { //nolint:revive,staticcheck // Conversion functions follow the generated naming scheme.
+	switch {
+	case in.ID != "":
+		return errors.New("v1alpha1 does not support filtering nested objects by id")
+	case !in.CreationTimestamp.After.IsZero() || !in.CreationTimestamp.Before.IsZero():
+		return errors.New("v1alpha1 does not support filtering by creation timestamp")
+	case len(in.Labels) > 0:
+		return errors.New("v1alpha1 does not support filtering by labels")
+	case in.JSONPath != "":
+		return errors.New("v1alpha1 does not support filtering by jsonpath")
+	}
+	if err := autoConvert_v1alpha2_QueryFilter_To_v1alpha1_QueryFilter(in, out, s); err != nil {
+		return err
+	}
+	out.Group = in.GroupKind.APIGroup
+	out.Kind = in.GroupKind.Kind
+	return nil
+}
+
+// Convert_v1alpha2_QueryCondition_To_v1alpha1_QueryCondition converts a
+// v1alpha2 condition filter. Reasons cannot be expressed in v1alpha1.
+func Convert_v1alpha2_QueryCondition_To_v1alpha1_QueryCondition(in *v1alpha2.QueryCondition, out *QueryCondition, s conversion.Scope) error { //nolint:revive,staticcheck // Conversion functions follow the generated naming scheme.
+	if in.Reason != "" {
+		return errors.New("v1alpha1 does not support filtering by condition reason")
+	}
+	return autoConvert_v1alpha2_QueryCondition_To_v1alpha1_QueryCondition(in, out, s)
+}
+
+// Convert_v1alpha1_QueryFilterControlPlane_To_v1alpha2_QueryFilterControlPlane
+// converts a control plane filter. The namespace of a control plane is its
+// group.
+func Convert_v1alpha1_QueryFilterControlPlane_To_v1alpha2_QueryFilterControlPlane(in *QueryFilterControlPlane, out *v1alpha2.QueryFilterControlPlane, s conversion.Scope) error { //nolint:revive,staticcheck // Conversion functions follow the generated naming scheme.
+	if err := autoConvert_v1alpha1_QueryFilterControlPlane_To_v1alpha2_QueryFilterControlPlane(in, out, s); err != nil {
+		return err
+	}
+	out.Group = in.Namespace
+	return nil
+}
+
+// Convert_v1alpha2_QueryFilterControlPlane_To_v1alpha1_QueryFilterControlPlane
+// converts a control plane filter. The namespace of a control plane is its
+// group.
+func Convert_v1alpha2_QueryFilterControlPlane_To_v1alpha1_QueryFilterControlPlane(in *v1alpha2.QueryFilterControlPlane, out *QueryFilterControlPlane, s conversion.Scope) error { //nolint:revive,staticcheck // Conversion functions follow the generated naming scheme.
+	if err := autoConvert_v1alpha2_QueryFilterControlPlane_To_v1alpha1_QueryFilterControlPlane(in, out, s); err != nil {
+		return err
+	}
+	out.Namespace = in.Group
+	return nil
+}
+
+// Convert_v1alpha1_QueryNestedResources_To_v1alpha2_QueryNestedResources
+// converts a relation's filter into a list of at most one filter.
+func Convert_v1alpha1_QueryNestedResources_To_v1alpha2_QueryNestedResources(in *QueryNestedResources, out *v1alpha2.QueryNestedResources, s conversion.Scope) error { //nolint:revive,staticcheck // Conversion functions follow the generated naming scheme.
+	if err := autoConvert_v1alpha1_QueryNestedResources_To_v1alpha2_QueryNestedResources(in, out, s); err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(in.Filter, QueryFilter{}) {
+		return nil
+	}
+	out.Filters = make([]v1alpha2.QueryFilter, 1)
+	return Convert_v1alpha1_QueryFilter_To_v1alpha2_QueryFilter(&in.Filter, &out.Filters[0], s)
+}
+
+// Convert_v1alpha2_QueryNestedResources_To_v1alpha1_QueryNestedResources
+// converts a relation's filters. v1alpha1 supports at most one filter.
+func Convert_v1alpha2_QueryNestedResources_To_v1alpha1_QueryNestedResources(in *v1alpha2.QueryNestedResources, out *QueryNestedResources, s conversion.Scope) error { //nolint:revive,staticcheck // Conversion functions follow the generated naming scheme.
+	if err := autoConvert_v1alpha2_QueryNestedResources_To_v1alpha1_QueryNestedResources(in, out, s); err != nil {
+		return err
+	}
+	switch len(in.Filters) {
+	case 0:
+		return nil
+	case 1:
+		return Convert_v1alpha2_QueryFilter_To_v1alpha1_QueryFilter(&in.Filters[0], &out.Filter, s)
+	default:
+		return fmt.Errorf("cannot convert %d relation filters: %w", len(in.Filters), errMultipleFilters)
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha2.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestConvertToV1alpha2(t *testing.T) {
+	type want struct {
+		spec *v1alpha2.QuerySpec
+		err  bool
+	}
+	cases := map[string]struct {
+		reason string
+		spec   *QuerySpec
+		want   want
+	}{
+		"SingleFilter": {
+			reason: "A filter without ids should be converted into a single object filter.",
+			spec: &QuerySpec{
+				QueryTopLevelResources: QueryTopLevelResources{
+					QueryResources: QueryResources{Limit: 10},
+					Filter: QueryTopLevelFilter{
+						ControlPlane: QueryFilterControlPlane{Name: "ctp", Namespace: "default"},
+						QueryFilter:  QueryFilter{Group: "apps", Kind: "Deployment", Conditions: []QueryCondition{{Type: "Ready", Status: "True"}}},
+					},
+				},
+				Freshness: []Freshness{{Group: "default", ControlPlane: "ctp", ResourceVersion: "42"}},
+			},
+			want: want{spec: &v1alpha2.QuerySpec{
+				QueryTopLevelResources: v1alpha2.QueryTopLevelResources{
+					QueryResources: v1alpha2.QueryResources{Limit: 10},
+					Filter: v1alpha2.QueryTopLevelFilter{
+						ControlPlane: v1alpha2.QueryFilterControlPlane{Name: "ctp", Group: "default"},
+						Objects: []v1alpha2.QueryFilter{{
+							GroupKind:  v1alpha2.QueryGroupKind{APIGroup: "apps", Kind: "Deployment"},
+							Conditions: []v1alpha2.QueryCondition{{Type: "Ready", Status: "True"}},
+						}},
+					},
+				},
+				Freshness: []v1alpha2.Freshness{{Group: "default", ControlPlane: "ctp", ResourceVersion: "42"}},
+			}},
+		},
+		"IDs": {
+			reason: "A filter with ids should be converted into one object filter per id.",
+			spec: &QuerySpec{
+				QueryTopLevelResources: QueryTopLevelResources{
+					Filter: QueryTopLevelFilter{IDs: []string{"a", "b"}, QueryFilter: QueryFilter{Namespace: "ns"}},
+					QueryResources: QueryResources{Objects: &QueryObjects{Relations: map[string]QueryRelation{
+						"events": {QueryNestedResources: QueryNestedResources{Filter: QueryFilter{Kind: "Event"}}},
+						"owners": {},
+					}}},
+				},
+			},
+			want: want{spec: &v1alpha2.QuerySpec{
+				QueryTopLevelResources: v1alpha2.QueryTopLevelResources{
+					Filter: v1alpha2.QueryTopLevelFilter{Objects: []v1alpha2.QueryFilter{
+						{ID: "a", Namespace: "ns"},
+						{ID: "b", Namespace: "ns"},
+					}},
+					QueryResources: v1alpha2.QueryResources{Objects: &v1alpha2.QueryObjects{Relations: map[string]v1alpha2.QueryRelation{
+						"events": {QueryNestedResources: v1alpha2.QueryNestedResources{Filters: []v1alpha2.QueryFilter{{GroupKind: v1alpha2.QueryGroupKind{Kind: "Event"}}}}},
+						"owners": {},
+					}}},
+				},
+			}},
+		},
+		"SQL": {
+			reason: "SQL filters cannot be expressed in v1alpha2.",
+			spec: &QuerySpec{
+				QueryTopLevelResources: QueryTopLevelResources{Filter: QueryTopLevelFilter{QueryFilter: QueryFilter{SQL: "true"}}},
+			},
+			want: want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := &SpaceQuery{ObjectMeta: metav1.ObjectMeta{Name: "q"}, Spec: tc.spec}
+			out := &v1alpha2.SpaceQuery{}
+			err := newScheme(t).Convert(in, out, nil)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nConvert(...): -want error, +got error:\n%s\n%v", tc.reason, diff, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.spec, out.Spec); diff != "" {
+				t.Errorf("\n%s\nConvert(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConvertFromV1alpha2(t *testing.T) {
+	type want struct {
+		spec *QuerySpec
+		err  bool
+	}
+	cases := map[string]struct {
+		reason string
+		spec   *v1alpha2.QuerySpec
+		want   want
+	}{
+		"IDs": {
+			reason: "Object filters that differ only by id should be converted into a filter with ids.",
+			spec: &v1alpha2.QuerySpec{QueryTopLevelResources: v1alpha2.QueryTopLevelResources{
+				Filter: v1alpha2.QueryTopLevelFilter{Objects: []v1alpha2.QueryFilter{
+					{ID: "a", GroupKind: v1alpha2.QueryGroupKind{Kind: "Pod"}},
+					{ID: "b", GroupKind: v1alpha2.QueryGroupKind{Kind: "Pod"}},
+				}},
+			}},
+			want: want{spec: &QuerySpec{QueryTopLevelResources: QueryTopLevelResources{
+				Filter: QueryTopLevelFilter{IDs: []string{"a", "b"}, QueryFilter: QueryFilter{Kind: "Pod"}},
+			}}},
+		},
+		"NoFilters": {
+			reason: "A query without object filters should be converted into an empty filter.",
+			spec:   &v1alpha2.QuerySpec{QueryTopLevelResources: v1alpha2.QueryTopLevelResources{QueryResources: v1alpha2.QueryResources{Count: true}}},
+			want: want{spec: &QuerySpec{QueryTopLevelResources: QueryTopLevelResources{
+				QueryResources: QueryResources{Count: true},
+			}}},
+		},
+		"MultipleFilters": {
+			reason: "Distinct object filters cannot be expressed in v1alpha1.",
+			spec: &v1alpha2.QuerySpec{QueryTopLevelResources: v1alpha2.QueryTopLevelResources{
+				Filter: v1alpha2.QueryTopLevelFilter{Objects: []v1alpha2.QueryFilter{
+					{GroupKind: v1alpha2.QueryGroupKind{Kind: "Pod"}},
+					{GroupKind: v1alpha2.QueryGroupKind{Kind: "Deployment"}},
+				}},
+			}},
+			want: want{err: true},
+		},
+		"MixedIDs": {
+			reason: "Object filters of which only some select an id cannot be expressed in v1alpha1.",
+			spec: &v1alpha2.QuerySpec{QueryTopLevelResources: v1alpha2.QueryTopLevelResources{
+				Filter: v1alpha2.QueryTopLevelFilter{Objects: []v1alpha2.QueryFilter{{ID: "a"}, {}}},
+			}},
+			want: want{err: true},
+		},
+		"Labels": {
+			reason: "Label filters cannot be expressed in v1alpha1.",
+			spec: &v1alpha2.QuerySpec{QueryTopLevelResources: v1alpha2.QueryTopLevelResources{
+				Filter: v1alpha2.QueryTopLevelFilter{Objects: []v1alpha2.QueryFilter{{Labels: map[string]string{"a": "b"}}}},
+			}},
+			want: want{err: true},
+		},
+		"MultipleRelationFilters": {
+			reason: "Relations with multiple filters cannot be expressed in v1alpha1.",
+			spec: &v1alpha2.QuerySpec{QueryTopLevelResources: v1alpha2.QueryTopLevelResources{
+				QueryResources: v1alpha2.QueryResources{Objects: &v1alpha2.QueryObjects{Relations: map[string]v1alpha2.QueryRelation{
+					"events": {QueryNestedResources: v1alpha2.QueryNestedResources{Filters: []v1alpha2.QueryFilter{{Name: "a"}, {Name: "b"}}}},
+				}}},
+			}},
+			want: want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := &v1alpha2.GroupQuery{ObjectMeta: metav1.ObjectMeta{Name: "q", Namespace: "default"}, Spec: tc.spec}
+			out := &GroupQuery{}
+			err := newScheme(t).Convert(in, out, nil)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nConvert(...): -want error, +got error:\n%s\n%v", tc.reason, diff, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.spec, out.Spec); diff != "" {
+				t.Errorf("\n%s\nConvert(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -13,5 +13,6 @@
 // limitations under the License.
 
 // +k8s:openapi-gen=true
+// +k8s:conversion-gen=github.com/upbound/up-sdk-go/apis/query/v1alpha2
 
 package v1alpha1
@@ -38,4 +38,13 @@ var (
 
 	// AddToScheme adds the types in this group-version to the given scheme.
 	AddToScheme = SchemeBuilder.AddToScheme
+
+	// localSchemeBuilder is used by generated conversions to register
+	// themselves with the SchemeBuilder.
+	localSchemeBuilder = &SchemeBuilder.SchemeBuilder
 )
+
+// GroupVersions returns the group/versions defined in this package.
+func GroupVersions() []schema.GroupVersion {
+	return []schema.GroupVersion{SchemeGroupVersion}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/upbound/up-sdk-go/apis/query/internal/validation"
+)
+
+var (
+	validDirections = sets.New(Ascending, Descending)
+	validGroupings  = sets.New(ByGVKsAndColumns)
+)
+
+const freshnessUnsupported = "freshness is unsupported in Spaces v1.15+ and in standalone UXP control planes"
+
+// Validate validates the spec of a query. It catches mistakes that would
+// otherwise only be reported by the query server.
+func (s *QuerySpec) Validate() field.ErrorList {
+	pth := field.NewPath("spec")
+	var errs field.ErrorList
+
+	if len(s.Freshness) > 0 {
+		errs = append(errs, field.Forbidden(pth.Child("freshness"), freshnessUnsupported))
+	}
+	errs = append(errs, validateResources(pth, &s.QueryResources)...)
+
+	fp := pth.Child("filter")
+	for i, id := range s.Filter.IDs {
+		if id == "" {
+			errs = append(errs, field.Invalid(fp.Child("ids").Index(i), id, "must not be empty"))
+		}
+	}
+	return append(errs, validateFilter(fp, &s.Filter.QueryFilter)...)
+}
+
+func validateResources(pth *field.Path, r *QueryResources) field.ErrorList {
+	errs := validation.Page(pth, r.Limit, r.Page.First, r.Page.Cursor)
+	for i := range r.Order {
+		errs = append(errs, validateOrder(pth.Child("order").Index(i), &r.Order[i])...)
+	}
+
+	if r.Objects == nil {
+		return errs
+	}
+	op := pth.Child("objects")
+	if r.Objects.Object != nil {
+		errs = append(errs, validation.Skeleton(op.Child("object"), r.Objects.Object.Object)...)
+	}
+	if t := r.Objects.Table; t != nil {
+		errs = append(errs, validation.Grouping(op.Child("table", "grouping"), t.Grouping, validGroupings)...)
+	}
+	for _, name := range sets.List(sets.KeySet(r.Objects.Relations)) {
+		rel := r.Objects.Relations[name]
+		rp := op.Child("relations").Key(name)
+		errs = append(errs, validateResources(rp, &rel.QueryResources)...)
+		errs = append(errs, validateFilter(rp.Child("filter"), &rel.Filter)...)
+	}
+	return errs
+}
+
+func validateOrder(pth *field.Path, o *QueryOrder) field.ErrorList {
+	return validation.Order(pth, validDirections,
+		validation.OrderField[Direction]{Name: "creationTimestamp", Direction: o.CreationTimestamp},
+		validation.OrderField[Direction]{Name: "name", Direction: o.Name},
+		validation.OrderField[Direction]{Name: "namespace", Direction: o.Namespace},
+		validation.OrderField[Direction]{Name: "apiGroup", Direction: o.APIGroup},
+		validation.OrderField[Direction]{Name: "kind", Direction: o.Kind},
+		validation.OrderField[Direction]{Name: "group", Direction: o.Group},
+		validation.OrderField[Direction]{Name: "cluster", Direction: o.ControlPlane},
+	)
+}
+
+func validateFilter(pth *field.Path, f *QueryFilter) field.ErrorList {
+	var errs field.ErrorList
+	for i, c := range f.Conditions {
+		cp := pth.Child("conditions").Index(i)
+		if c.Type == "" {
+			errs = append(errs, field.Required(cp.Child("type"), ""))
+		}
+		if !validation.ConditionStatuses.Has(c.Status) {
+			errs = append(errs, field.NotSupported(cp.Child("status"), c.Status, sets.List(validation.ConditionStatuses)))
+		}
+	}
+	for i, o := range f.Owners {
+		if o.Kind == "" && o.UID == "" {
+			errs = append(errs, field.Required(pth.Child("owners").Index(i), "either kind or uid must be set"))
+		}
+	}
+	return errs
+}
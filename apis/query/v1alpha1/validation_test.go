@@ -0,0 +1,108 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+)
+
+func TestQuerySpecValidate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		spec   QuerySpec
+		want   []string
+	}{
+		"Valid": {
+			reason: "A well formed spec should be valid.",
+			spec: QuerySpec{
+				QueryTopLevelResources: QueryTopLevelResources{
+					Filter: QueryTopLevelFilter{
+						IDs:         []string{"abc"},
+						QueryFilter: QueryFilter{Conditions: []QueryCondition{{Type: "Ready", Status: "True"}}},
+					},
+					QueryResources: QueryResources{
+						Page:  QueryPage{Cursor: "abc"},
+						Order: []QueryOrder{{CreationTimestamp: Descending}},
+						Objects: &QueryObjects{
+							Object: &common.JSON{Object: map[string]interface{}{"metadata": map[string]interface{}{"name": true}}},
+							Relations: map[string]QueryRelation{"owners": {
+								QueryNestedResources: QueryNestedResources{Filter: QueryFilter{Owners: []QueryOwner{{UID: "xyz"}}}},
+							}},
+						},
+					},
+				},
+			},
+		},
+		"Freshness": {
+			reason: "Freshness should be rejected because it is deprecated.",
+			spec:   QuerySpec{Freshness: []Freshness{{Group: "default", ControlPlane: "ctp", ResourceVersion: "42"}}},
+			want:   []string{"spec.freshness: Forbidden: " + freshnessUnsupported},
+		},
+		"Paging": {
+			reason: "Limits and pages should not be negative, and a page should not set both a cursor and a first object.",
+			spec: QuerySpec{QueryTopLevelResources: QueryTopLevelResources{
+				QueryResources: QueryResources{Limit: -1, Page: QueryPage{Cursor: "abc", First: 2}},
+			}},
+			want: []string{
+				"spec.limit: Invalid value: -1: must not be negative",
+				"spec.page.first: Invalid value: 2: must not be set together with cursor",
+			},
+		},
+		"Order": {
+			reason: "Orders should have exactly one field with a valid direction.",
+			spec: QuerySpec{QueryTopLevelResources: QueryTopLevelResources{
+				QueryResources: QueryResources{Order: []QueryOrder{{Name: "Up"}, {}}},
+			}},
+			want: []string{
+				`spec.order[0].name: Unsupported value: "Up": supported values: "Asc", "Desc"`,
+				"spec.order[1]: Invalid value: 0: exactly one field must be set",
+			},
+		},
+		"Filters": {
+			reason: "Empty IDs, invalid conditions and owners should be rejected, including in relations.",
+			spec: QuerySpec{QueryTopLevelResources: QueryTopLevelResources{
+				Filter: QueryTopLevelFilter{
+					IDs:         []string{""},
+					QueryFilter: QueryFilter{Conditions: []QueryCondition{{Type: "Ready"}}},
+				},
+				QueryResources: QueryResources{Objects: &QueryObjects{
+					Relations: map[string]QueryRelation{"owners": {
+						QueryNestedResources: QueryNestedResources{Filter: QueryFilter{Owners: []QueryOwner{{APIGroup: "apps"}}}},
+					}},
+				}},
+			}},
+			want: []string{
+				"spec.objects.relations[owners].filter.owners[0]: Required value: either kind or uid must be set",
+				`spec.filter.ids[0]: Invalid value: "": must not be empty`,
+				`spec.filter.conditions[0].status: Unsupported value: "": supported values: "False", "True", "Unknown"`,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for _, err := range tc.spec.Validate() {
+				got = append(got, err.Error())
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
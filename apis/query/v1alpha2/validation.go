@@ -0,0 +1,148 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha2
+
+import (
+	"fmt"
+
+	"github.com/theory/jsonpath"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/upbound/up-sdk-go/apis/query/internal/validation"
+)
+
+var (
+	validDirections = sets.New(Ascending, Descending)
+	validGroupings  = sets.New(ByGVKsAndColumns)
+)
+
+const freshnessUnsupported = "freshness is unsupported in Spaces v1.15+ and in standalone UXP control planes"
+
+// Validate validates the spec of a query. It catches mistakes that would
+// otherwise only be reported by the query server.
+func (s *QuerySpec) Validate() field.ErrorList {
+	pth := field.NewPath("spec")
+	var errs field.ErrorList
+
+	if len(s.Freshness) > 0 {
+		errs = append(errs, field.Forbidden(pth.Child("freshness"), freshnessUnsupported))
+	}
+	errs = append(errs, validateResources(pth, &s.QueryResources)...)
+
+	fp := pth.Child("filter", "objects")
+	if len(s.Filter.Objects) == 0 {
+		errs = append(errs, field.Required(fp, "at least one filter is required, an empty filter matches all objects"))
+	}
+	for i := range s.Filter.Objects {
+		errs = append(errs, validateFilter(fp.Index(i), &s.Filter.Objects[i])...)
+	}
+	return errs
+}
+
+func validateResources(pth *field.Path, r *QueryResources) field.ErrorList {
+	errs := validation.Page(pth, r.Limit, r.Page.First, r.Page.Cursor)
+	for i := range r.Order {
+		errs = append(errs, ValidateOrder(pth.Child("order").Index(i), &r.Order[i])...)
+	}
+
+	if r.Objects == nil {
+		return errs
+	}
+	op := pth.Child("objects")
+	if r.Objects.Object != nil {
+		errs = append(errs, validation.Skeleton(op.Child("object"), r.Objects.Object.Object)...)
+	}
+	if t := r.Objects.Table; t != nil {
+		errs = append(errs, validation.Grouping(op.Child("table", "grouping"), t.Grouping, validGroupings)...)
+	}
+	for _, name := range sets.List(sets.KeySet(r.Objects.Relations)) {
+		rel := r.Objects.Relations[name]
+		rp := op.Child("relations").Key(name)
+		errs = append(errs, validateResources(rp, &rel.QueryResources)...)
+		for i := range rel.Filters {
+			errs = append(errs, validateFilter(rp.Child("filters").Index(i), &rel.Filters[i])...)
+		}
+	}
+	return errs
+}
+
+// ValidateOrder validates that exactly one field of an order is set, to a
+// supported direction.
+func ValidateOrder(pth *field.Path, o *QueryOrder) field.ErrorList {
+	return validation.Order(pth, validDirections,
+		validation.OrderField[Direction]{Name: "creationTimestamp", Direction: o.CreationTimestamp},
+		validation.OrderField[Direction]{Name: "name", Direction: o.Name},
+		validation.OrderField[Direction]{Name: "namespace", Direction: o.Namespace},
+		validation.OrderField[Direction]{Name: "apiGroup", Direction: o.APIGroup},
+		validation.OrderField[Direction]{Name: "kind", Direction: o.Kind},
+		validation.OrderField[Direction]{Name: "group", Direction: o.Group},
+		validation.OrderField[Direction]{Name: "cluster", Direction: o.ControlPlane},
+	)
+}
+
+func validateFilter(pth *field.Path, f *QueryFilter) field.ErrorList {
+	errs := ValidateCreationTimestamp(pth.Child("creationTimestamp"), &f.CreationTimestamp)
+	for i := range f.Conditions {
+		errs = append(errs, ValidateCondition(pth.Child("conditions").Index(i), &f.Conditions[i])...)
+	}
+	if f.JSONPath != "" {
+		if err := ValidateFilterExpression(pth.Child("jsonpath"), f.JSONPath); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// ValidateCreationTimestamp validates that the end of a creation timestamp
+// range is after its start, if both are set.
+func ValidateCreationTimestamp(pth *field.Path, ct *QueryCreationTimestamp) field.ErrorList {
+	if ct.After.IsZero() || ct.Before.IsZero() || ct.After.Before(&ct.Before) {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(pth.Child("before"), ct.Before, "must be after creationTimestamp.after")}
+}
+
+// ValidateCondition validates that a condition filter has a type, and a
+// supported status if it has one.
+func ValidateCondition(pth *field.Path, c *QueryCondition) field.ErrorList {
+	var errs field.ErrorList
+	if c.Type == "" {
+		errs = append(errs, field.Required(pth.Child("type"), ""))
+	}
+	if c.Status != "" && !validation.ConditionStatuses.Has(c.Status) {
+		errs = append(errs, field.NotSupported(pth.Child("status"), c.Status, sets.List(validation.ConditionStatuses)))
+	}
+	return errs
+}
+
+// ValidateFilterExpression validates that the given string is either an RFC
+// 9535 JSONPath query starting with '$', or a filter expression such as
+// "@.spec.replicas > 1" that is valid within a filter selector.
+func ValidateFilterExpression(pth *field.Path, s string) *field.Error {
+	if s == "" {
+		return field.Invalid(pth, s, "must not be empty")
+	}
+	if s[0] == '$' {
+		if _, err := jsonpath.Parse(s); err != nil {
+			return field.Invalid(pth, s, fmt.Sprintf("must be a valid JSONPath expression: %s", err))
+		}
+		return nil
+	}
+	if _, err := jsonpath.Parse("$[?" + s + "]"); err != nil {
+		return field.Invalid(pth, s, fmt.Sprintf("must be a valid JSONPath filter expression: %s", err))
+	}
+	return nil
+}
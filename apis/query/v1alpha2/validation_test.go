@@ -0,0 +1,198 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha2
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+)
+
+func TestQuerySpecValidate(t *testing.T) {
+	t0 := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	t1 := metav1.NewTime(t0.Add(time.Hour))
+	all := QueryTopLevelFilter{Objects: []QueryFilter{{}}}
+
+	cases := map[string]struct {
+		reason string
+		spec   QuerySpec
+		want   []string
+	}{
+		"Valid": {
+			reason: "A well formed spec should be valid.",
+			spec: QuerySpec{
+				QueryTopLevelResources: QueryTopLevelResources{
+					Filter: QueryTopLevelFilter{Objects: []QueryFilter{{
+						CreationTimestamp: QueryCreationTimestamp{After: t0, Before: t1},
+						Conditions:        []QueryCondition{{Type: "Ready", Status: "True"}},
+						JSONPath:          "@.spec.replicas > 1",
+					}}},
+					QueryResources: QueryResources{
+						Limit: 10,
+						Page:  QueryPage{Cursor: "abc"},
+						Order: []QueryOrder{{Name: Ascending}},
+						Objects: &QueryObjects{
+							Object: &common.JSON{Object: map[string]interface{}{"spec": true}},
+							Relations: map[string]QueryRelation{"owners": {
+								QueryNestedResources: QueryNestedResources{Filters: []QueryFilter{{JSONPath: "$.spec"}}},
+							}},
+						},
+					},
+				},
+			},
+		},
+		"Freshness": {
+			reason: "Freshness should be rejected because it is deprecated.",
+			spec: QuerySpec{
+				Freshness:              []Freshness{{ControlPlane: "ctp", Group: "default", ResourceVersion: "1"}},
+				QueryTopLevelResources: QueryTopLevelResources{Filter: all},
+			},
+			want: []string{"spec.freshness: Forbidden: " + freshnessUnsupported},
+		},
+		"NoObjectFilters": {
+			reason: "At least one object filter should be required.",
+			spec:   QuerySpec{},
+			want:   []string{"spec.filter.objects: Required value: at least one filter is required, an empty filter matches all objects"},
+		},
+		"CursorAndFirst": {
+			reason: "A page should not set both a cursor and a first object.",
+			spec: QuerySpec{QueryTopLevelResources: QueryTopLevelResources{
+				Filter:         all,
+				QueryResources: QueryResources{Page: QueryPage{Cursor: "abc", First: 2}},
+			}},
+			want: []string{"spec.page.first: Invalid value: 2: must not be set together with cursor"},
+		},
+		"Order": {
+			reason: "Orders should have exactly one field with a valid direction.",
+			spec: QuerySpec{QueryTopLevelResources: QueryTopLevelResources{
+				Filter: all,
+				QueryResources: QueryResources{Order: []QueryOrder{
+					{Name: "Up"},
+					{Name: Ascending, Kind: Descending},
+				}},
+			}},
+			want: []string{
+				`spec.order[0].name: Unsupported value: "Up": supported values: "Asc", "Desc"`,
+				"spec.order[1]: Invalid value: 2: exactly one field must be set",
+			},
+		},
+		"Filters": {
+			reason: "Invalid creation timestamp ranges, conditions and JSONPath expressions should be rejected, including in relations.",
+			spec: QuerySpec{QueryTopLevelResources: QueryTopLevelResources{
+				Filter: QueryTopLevelFilter{Objects: []QueryFilter{{
+					CreationTimestamp: QueryCreationTimestamp{After: t1, Before: t0},
+					Conditions:        []QueryCondition{{Status: "Maybe"}},
+				}}},
+				QueryResources: QueryResources{Objects: &QueryObjects{
+					Relations: map[string]QueryRelation{"events": {
+						QueryNestedResources: QueryNestedResources{Filters: []QueryFilter{{JSONPath: "@.spec["}}},
+					}},
+				}},
+			}},
+			want: []string{
+				`spec.objects.relations[events].filters[0].jsonpath: Invalid value: "@.spec[": must be a valid JSONPath filter expression`,
+				`spec.filter.objects[0].creationTimestamp.before: Invalid value: "2025-01-01T00:00:00Z": must be after creationTimestamp.after`,
+				"spec.filter.objects[0].conditions[0].type: Required value",
+				`spec.filter.objects[0].conditions[0].status: Unsupported value: "Maybe": supported values: "False", "True", "Unknown"`,
+			},
+		},
+		"EmptyCreationTimestampRange": {
+			reason: "A creation timestamp range that ends where it starts matches no objects and should be rejected.",
+			spec: QuerySpec{QueryTopLevelResources: QueryTopLevelResources{
+				Filter: QueryTopLevelFilter{Objects: []QueryFilter{{
+					CreationTimestamp: QueryCreationTimestamp{After: t0, Before: t0},
+				}}},
+			}},
+			want: []string{
+				`spec.filter.objects[0].creationTimestamp.before: Invalid value: "2025-01-01T00:00:00Z": must be after creationTimestamp.after`,
+			},
+		},
+		"Skeleton": {
+			reason: "Object skeletons should only contain true leaves.",
+			spec: QuerySpec{QueryTopLevelResources: QueryTopLevelResources{
+				Filter: all,
+				QueryResources: QueryResources{Objects: &QueryObjects{
+					Object: &common.JSON{Object: map[string]interface{}{"spec": false}},
+				}},
+			}},
+			want: []string{"spec.objects.object.spec: Invalid value: false: must be true or an object"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := errorStrings(tc.spec.Validate())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValidateFilterExpression(t *testing.T) {
+	cases := map[string]struct {
+		expr    string
+		wantErr string
+	}{
+		"Empty": {
+			expr:    "",
+			wantErr: `spec.jsonpath: Invalid value: "": must not be empty`,
+		},
+		"Query": {
+			expr:    "$.spec.replicas",
+			wantErr: "<nil>",
+		},
+		"Filter": {
+			expr:    `@.metadata.name == "foo"`,
+			wantErr: "<nil>",
+		},
+		"InvalidFilter": {
+			expr:    "@.spec[",
+			wantErr: `spec.jsonpath: Invalid value: "@.spec[": must be a valid JSONPath filter expression`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateFilterExpression(field.NewPath("spec", "jsonpath"), tc.expr)
+			if diff := cmp.Diff(tc.wantErr, truncate(fmt.Sprintf("%v", err))); diff != "" {
+				t.Errorf("ValidateFilterExpression(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// errorStrings returns the errors as strings. Parser details of invalid
+// JSONPath expressions are truncated.
+func errorStrings(errs field.ErrorList) []string {
+	var out []string
+	for _, err := range errs {
+		out = append(out, truncate(err.Error()))
+	}
+	return out
+}
+
+// truncate strips parser details from JSONPath errors.
+func truncate(s string) string {
+	if i := strings.Index(s, "expression: "); i >= 0 {
+		return s[:i+len("expression")]
+	}
+	return s
+}
@@ -17,16 +17,46 @@ package apis
 
 import (
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	adminv1alpha1 "github.com/upbound/up-sdk-go/apis/admin/v1alpha1"
+	authorizationv1alpha1 "github.com/upbound/up-sdk-go/apis/authorization/v1alpha1"
 	connectv1alpha1 "github.com/upbound/up-sdk-go/apis/connect/v1alpha1"
+	licensingv1alpha1 "github.com/upbound/up-sdk-go/apis/licensing/v1alpha1"
+	observabilityv1alpha1 "github.com/upbound/up-sdk-go/apis/observability/v1alpha1"
+	metav1alpha1 "github.com/upbound/up-sdk-go/apis/pkg/meta/v1alpha1"
+	metav1beta1 "github.com/upbound/up-sdk-go/apis/pkg/meta/v1beta1"
+	pkgv1alpha1 "github.com/upbound/up-sdk-go/apis/pkg/v1alpha1"
+	pkgv1beta1 "github.com/upbound/up-sdk-go/apis/pkg/v1beta1"
 	queryv1alpha1 "github.com/upbound/up-sdk-go/apis/query/v1alpha1"
 	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+	referencesv1alpha1 "github.com/upbound/up-sdk-go/apis/references/v1alpha1"
+	schedulingv1alpha1 "github.com/upbound/up-sdk-go/apis/scheduling/v1alpha1"
 	spacesv1alpha1 "github.com/upbound/up-sdk-go/apis/spaces/v1alpha1"
 	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
 	upboundv1alpha1 "github.com/upbound/up-sdk-go/apis/upbound/v1alpha1"
 )
 
+// groupVersions list the group/versions of each API package.
+var groupVersions = []func() []schema.GroupVersion{
+	adminv1alpha1.GroupVersions,
+	authorizationv1alpha1.GroupVersions,
+	connectv1alpha1.GroupVersions,
+	licensingv1alpha1.GroupVersions,
+	observabilityv1alpha1.GroupVersions,
+	metav1alpha1.GroupVersions,
+	metav1beta1.GroupVersions,
+	pkgv1alpha1.GroupVersions,
+	pkgv1beta1.GroupVersions,
+	queryv1alpha1.GroupVersions,
+	queryv1alpha2.GroupVersions,
+	referencesv1alpha1.GroupVersions,
+	schedulingv1alpha1.GroupVersions,
+	spacesv1alpha1.GroupVersions,
+	spacesv1beta1.GroupVersions,
+	upboundv1alpha1.GroupVersions,
+}
+
 func init() {
 	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
 	AddToSchemes = append(AddToSchemes,
@@ -36,7 +66,16 @@ func init() {
 		queryv1alpha1.SchemeBuilder.AddToScheme,
 		upboundv1alpha1.SchemeBuilder.AddToScheme,
 		adminv1alpha1.SchemeBuilder.AddToScheme,
+		authorizationv1alpha1.SchemeBuilder.AddToScheme,
 		connectv1alpha1.SchemeBuilder.AddToScheme,
+		licensingv1alpha1.SchemeBuilder.AddToScheme,
+		observabilityv1alpha1.SchemeBuilder.AddToScheme,
+		metav1alpha1.SchemeBuilder.AddToScheme,
+		metav1beta1.SchemeBuilder.AddToScheme,
+		pkgv1alpha1.SchemeBuilder.AddToScheme,
+		pkgv1beta1.SchemeBuilder.AddToScheme,
+		referencesv1alpha1.SchemeBuilder.AddToScheme,
+		schedulingv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -47,3 +86,13 @@ var AddToSchemes runtime.SchemeBuilder
 func AddToScheme(s *runtime.Scheme) error {
 	return AddToSchemes.AddToScheme(s)
 }
+
+// GroupVersions returns the group/versions of every API defined in the
+// project, all of which are registered by AddToScheme.
+func GroupVersions() []schema.GroupVersion {
+	gvs := make([]schema.GroupVersion, 0, len(groupVersions))
+	for _, fn := range groupVersions {
+		gvs = append(gvs, fn()...)
+	}
+	return gvs
+}
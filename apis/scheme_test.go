@@ -0,0 +1,88 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apis
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/apitesting/roundtrip"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/randfill"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+)
+
+// fuzzerFuncs fill types the fuzzer cannot fill on its own with values that
+// survive a JSON round trip.
+func fuzzerFuncs(_ runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		func(j *common.JSON, c randfill.Continue) {
+			j.Object = map[string]interface{}{c.String(0): c.String(0), "n": c.Int63()}
+		},
+		func(j *common.JSONObject, c randfill.Continue) {
+			j.Object = map[string]interface{}{c.String(0): c.String(0), "n": c.Int63()}
+		},
+		func(p **[]string, c randfill.Continue) {
+			// A pointer to a nil slice is serialized as null and decoded as a
+			// nil pointer.
+			*p = nil
+			if c.Bool() {
+				*p = &[]string{c.String(0)}
+			}
+		},
+	}
+}
+
+func TestGroupVersions(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	listed := map[schema.GroupVersion]bool{}
+	for _, gv := range GroupVersions() {
+		listed[gv] = true
+		if !s.IsVersionRegistered(gv) {
+			t.Errorf("GroupVersions(): %s is not registered by AddToScheme", gv)
+		}
+	}
+	for gvk := range s.AllKnownTypes() {
+		if gvk.Version == runtime.APIVersionInternal || !strings.HasSuffix(gvk.Group, "upbound.io") {
+			continue
+		}
+		if !listed[gvk.GroupVersion()] {
+			t.Errorf("GroupVersions(): %s is registered by AddToScheme but not listed", gvk.GroupVersion())
+		}
+	}
+}
+
+// TestRoundTrip tests that every kind registered by AddToScheme can be
+// serialized and deserialized without losing data.
+func TestRoundTrip(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	codecs := runtimeserializer.NewCodecFactory(s)
+	for seed := range int64(10) {
+		f := fuzzer.FuzzerFor(fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, fuzzerFuncs), rand.NewSource(seed), codecs) //nolint:gosec // Not used for security.
+		roundtrip.RoundTripExternalTypesWithoutProtobuf(t, s, codecs, f, nil)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+)
+
+// The backup types are copies of their v1beta1 counterparts, so the generated
+// conversions cover all of their fields. The v1beta1 types are the hubs the
+// functions below convert to and from.
+
+var (
+	_ conversion.Convertible = &Backup{}
+	_ conversion.Convertible = &BackupSchedule{}
+	_ conversion.Convertible = &SharedBackup{}
+	_ conversion.Convertible = &SharedBackupConfig{}
+	_ conversion.Convertible = &SharedBackupSchedule{}
+)
+
+// convert calls fn with the hub if it is of type H, then sets the kind of the
+// converted object.
+func convert[H conversion.Hub](hub conversion.Hub, converted runtime.Object, gvk schema.GroupVersionKind, fn func(H) error) error {
+	h, ok := hub.(H)
+	if !ok {
+		return fmt.Errorf("cannot convert %s: unexpected hub type %T", gvk.Kind, hub)
+	}
+	if err := fn(h); err != nil {
+		return err
+	}
+	converted.GetObjectKind().SetGroupVersionKind(gvk)
+	return nil
+}
+
+// ConvertTo converts this Backup to the hub version.
+func (b *Backup) ConvertTo(dst conversion.Hub) error {
+	return convert(dst, dst, v1beta1.SchemeGroupVersion.WithKind(v1beta1.BackupKind), func(hub *v1beta1.Backup) error {
+		return Convert_v1alpha1_Backup_To_v1beta1_Backup(b, hub, nil)
+	})
+}
+
+// ConvertFrom converts the hub version to this Backup.
+func (b *Backup) ConvertFrom(src conversion.Hub) error {
+	return convert(src, b, SchemeGroupVersion.WithKind(BackupKind), func(hub *v1beta1.Backup) error {
+		return Convert_v1beta1_Backup_To_v1alpha1_Backup(hub, b, nil)
+	})
+}
+
+// ConvertTo converts this BackupSchedule to the hub version.
+func (s *BackupSchedule) ConvertTo(dst conversion.Hub) error {
+	return convert(dst, dst, v1beta1.SchemeGroupVersion.WithKind(v1beta1.BackupScheduleKind), func(hub *v1beta1.BackupSchedule) error {
+		return Convert_v1alpha1_BackupSchedule_To_v1beta1_BackupSchedule(s, hub, nil)
+	})
+}
+
+// ConvertFrom converts the hub version to this BackupSchedule.
+func (s *BackupSchedule) ConvertFrom(src conversion.Hub) error {
+	return convert(src, s, SchemeGroupVersion.WithKind(BackupScheduleKind), func(hub *v1beta1.BackupSchedule) error {
+		return Convert_v1beta1_BackupSchedule_To_v1alpha1_BackupSchedule(hub, s, nil)
+	})
+}
+
+// ConvertTo converts this SharedBackup to the hub version.
+func (b *SharedBackup) ConvertTo(dst conversion.Hub) error {
+	return convert(dst, dst, v1beta1.SchemeGroupVersion.WithKind(v1beta1.SharedBackupKind), func(hub *v1beta1.SharedBackup) error {
+		return Convert_v1alpha1_SharedBackup_To_v1beta1_SharedBackup(b, hub, nil)
+	})
+}
+
+// ConvertFrom converts the hub version to this SharedBackup.
+func (b *SharedBackup) ConvertFrom(src conversion.Hub) error {
+	return convert(src, b, SchemeGroupVersion.WithKind(SharedBackupKind), func(hub *v1beta1.SharedBackup) error {
+		return Convert_v1beta1_SharedBackup_To_v1alpha1_SharedBackup(hub, b, nil)
+	})
+}
+
+// ConvertTo converts this SharedBackupConfig to the hub version.
+func (c *SharedBackupConfig) ConvertTo(dst conversion.Hub) error {
+	return convert(dst, dst, v1beta1.SchemeGroupVersion.WithKind(v1beta1.SharedBackupConfigKind), func(hub *v1beta1.SharedBackupConfig) error {
+		return Convert_v1alpha1_SharedBackupConfig_To_v1beta1_SharedBackupConfig(c, hub, nil)
+	})
+}
+
+// ConvertFrom converts the hub version to this SharedBackupConfig.
+func (c *SharedBackupConfig) ConvertFrom(src conversion.Hub) error {
+	return convert(src, c, SchemeGroupVersion.WithKind(SharedBackupConfigKind), func(hub *v1beta1.SharedBackupConfig) error {
+		return Convert_v1beta1_SharedBackupConfig_To_v1alpha1_SharedBackupConfig(hub, c, nil)
+	})
+}
+
+// ConvertTo converts this SharedBackupSchedule to the hub version.
+func (s *SharedBackupSchedule) ConvertTo(dst conversion.Hub) error {
+	return convert(dst, dst, v1beta1.SchemeGroupVersion.WithKind(v1beta1.SharedBackupScheduleKind), func(hub *v1beta1.SharedBackupSchedule) error {
+		return Convert_v1alpha1_SharedBackupSchedule_To_v1beta1_SharedBackupSchedule(s, hub, nil)
+	})
+}
+
+// ConvertFrom converts the hub version to this SharedBackupSchedule.
+func (s *SharedBackupSchedule) ConvertFrom(src conversion.Hub) error {
+	return convert(src, s, SchemeGroupVersion.WithKind(SharedBackupScheduleKind), func(hub *v1beta1.SharedBackupSchedule) error {
+		return Convert_v1beta1_SharedBackupSchedule_To_v1alpha1_SharedBackupSchedule(hub, s, nil)
+	})
+}
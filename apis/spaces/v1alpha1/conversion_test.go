@@ -0,0 +1,154 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/randfill"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	"github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1beta1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func newFuzzer(t *testing.T, seed int64) *randfill.Filler {
+	t.Helper()
+	funcs := func(_ runtimeserializer.CodecFactory) []interface{} {
+		return []interface{}{
+			func(j *common.JSONObject, c randfill.Continue) {
+				j.Object = map[string]interface{}{c.String(0): c.String(0), "n": c.Int63()}
+			},
+		}
+	}
+	return fuzzer.FuzzerFor(fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, funcs), rand.NewSource(seed), runtimeserializer.NewCodecFactory(newScheme(t))) //nolint:gosec // Not used for security.
+}
+
+// TestConvertRoundTrip tests that fuzzed objects survive a round trip through
+// the other version unchanged.
+func TestConvertRoundTrip(t *testing.T) {
+	cases := map[string]struct {
+		spoke func() conversion.Convertible
+		hub   func() conversion.Hub
+	}{
+		"Backup": {
+			spoke: func() conversion.Convertible { return &Backup{} },
+			hub:   func() conversion.Hub { return &v1beta1.Backup{} },
+		},
+		"BackupSchedule": {
+			spoke: func() conversion.Convertible { return &BackupSchedule{} },
+			hub:   func() conversion.Hub { return &v1beta1.BackupSchedule{} },
+		},
+		"SharedBackup": {
+			spoke: func() conversion.Convertible { return &SharedBackup{} },
+			hub:   func() conversion.Hub { return &v1beta1.SharedBackup{} },
+		},
+		"SharedBackupConfig": {
+			spoke: func() conversion.Convertible { return &SharedBackupConfig{} },
+			hub:   func() conversion.Hub { return &v1beta1.SharedBackupConfig{} },
+		},
+		"SharedBackupSchedule": {
+			spoke: func() conversion.Convertible { return &SharedBackupSchedule{} },
+			hub:   func() conversion.Hub { return &v1beta1.SharedBackupSchedule{} },
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for seed := range int64(20) {
+				f := newFuzzer(t, seed)
+
+				spoke := tc.spoke()
+				f.Fill(spoke)
+				spoke.GetObjectKind().SetGroupVersionKind(SchemeGroupVersion.WithKind(name))
+				hub := tc.hub()
+				if err := spoke.ConvertTo(hub); err != nil {
+					t.Fatalf("ConvertTo(...): %v", err)
+				}
+				got := tc.spoke()
+				if err := got.ConvertFrom(hub); err != nil {
+					t.Fatalf("ConvertFrom(...): %v", err)
+				}
+				if !equality.Semantic.DeepEqual(spoke, got) {
+					t.Errorf("seed %d: v1alpha1 -> v1beta1 -> v1alpha1: -want, +got:\n%s", seed, cmp.Diff(spoke, got))
+				}
+
+				hub = tc.hub()
+				f.Fill(hub)
+				hub.GetObjectKind().SetGroupVersionKind(v1beta1.SchemeGroupVersion.WithKind(name))
+				spoke = tc.spoke()
+				if err := spoke.ConvertFrom(hub); err != nil {
+					t.Fatalf("ConvertFrom(...): %v", err)
+				}
+				gotHub := tc.hub()
+				if err := spoke.ConvertTo(gotHub); err != nil {
+					t.Fatalf("ConvertTo(...): %v", err)
+				}
+				if !equality.Semantic.DeepEqual(hub, gotHub) {
+					t.Errorf("seed %d: v1beta1 -> v1alpha1 -> v1beta1: -want, +got:\n%s", seed, cmp.Diff(hub, gotHub))
+				}
+			}
+		})
+	}
+}
+
+func TestConvertScheme(t *testing.T) {
+	in := &SharedBackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nightly"},
+		Spec: SharedBackupScheduleSpec{
+			ControlPlaneSelector: ResourceSelector{Names: []string{"ctp1"}},
+		},
+	}
+	in.Spec.Schedule = "@daily"
+	out := &v1beta1.SharedBackupSchedule{}
+	if err := newScheme(t).Convert(in, out, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := &v1beta1.SharedBackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nightly"},
+		Spec: v1beta1.SharedBackupScheduleSpec{
+			ControlPlaneSelector: v1beta1.ResourceSelector{Names: []string{"ctp1"}},
+		},
+	}
+	want.Spec.Schedule = "@daily"
+	if diff := cmp.Diff(want, out); diff != "" {
+		t.Errorf("Convert(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestConvertUnexpectedHub(t *testing.T) {
+	if err := (&Backup{}).ConvertTo(&v1beta1.SharedBackup{}); err == nil {
+		t.Error("ConvertTo(...): expected an error converting to a hub of another kind")
+	}
+}
@@ -12,4 +12,6 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+// +k8s:conversion-gen=github.com/upbound/up-sdk-go/apis/spaces/v1beta1
+
 package v1alpha1
@@ -188,7 +188,7 @@ type SharedSecretStoreStatus struct {
 	// +listMapKey=controlPlane
 	Provisioned []SecretStoreProvisioningSuccess `json:"provisioned,omitempty"`
 
-	xpv1.ResourceStatus `json:",inline"`
+	xpv1.ConditionedStatus `json:",inline"`
 }
 
 // SecretStoreProvisioningFailure defines secret store provisioning failure.
@@ -1067,7 +1067,7 @@ func (in *SharedSecretStoreStatus) DeepCopyInto(out *SharedSecretStoreStatus) {
 		*out = make([]SecretStoreProvisioningSuccess, len(*in))
 		copy(*out, *in)
 	}
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedSecretStoreStatus.
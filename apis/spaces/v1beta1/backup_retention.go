@@ -0,0 +1,215 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// maxProjectedRuns bounds the runs counted when projecting the footprint of
+// a schedule.
+const maxProjectedRuns = 100000
+
+// BackupRetentionOptions configure how backup retention is planned.
+// +kubebuilder:object:generate=false
+// +k8s:conversion-gen=false
+type BackupRetentionOptions struct {
+	// Now is the time retention is planned at. It defaults to the current
+	// time.
+	Now time.Time
+
+	// ExpiringWithin is how soon a retained backup must expire to be
+	// reported as about to expire.
+	ExpiringWithin time.Duration
+
+	// Sizes are the object storage sizes of backups by name, if known.
+	Sizes map[string]resource.Quantity
+}
+
+// BackupRetention is the retention of a single backup.
+// +kubebuilder:object:generate=false
+// +k8s:conversion-gen=false
+type BackupRetention struct {
+	// Name of the backup.
+	Name string
+
+	// ExpiresAt is the time the backup expires, or nil if it never does.
+	ExpiresAt *time.Time
+
+	// DeletionPolicy of the backup. It defaults to Orphan.
+	DeletionPolicy xpv1.DeletionPolicy
+
+	// Size of the backup in object storage, if known.
+	Size *resource.Quantity
+}
+
+// BackupDeletion is a deletion of an expired backup.
+// +kubebuilder:object:generate=false
+// +k8s:conversion-gen=false
+type BackupDeletion struct {
+	// Name of the backup.
+	Name string
+
+	// DeleteData is true if the backup's data is deleted from object storage
+	// along with the backup, i.e. its deletion policy is Delete. Data of
+	// backups with deletion policy Orphan is kept.
+	DeleteData bool
+}
+
+// BackupRetentionPlan is the retention of the backups of a schedule.
+// +kubebuilder:object:generate=false
+// +k8s:conversion-gen=false
+type BackupRetentionPlan struct {
+	// Expired backups, oldest first.
+	Expired []BackupRetention
+
+	// Expiring backups are retained but expire within the configured
+	// duration, oldest first.
+	Expiring []BackupRetention
+
+	// Retained backups don't expire within the configured duration, oldest
+	// first.
+	Retained []BackupRetention
+
+	// Deletions is the dry-run plan of deleting the expired backups.
+	Deletions []BackupDeletion
+
+	// RetainedSize is the known size of the expiring and retained backups.
+	RetainedSize resource.Quantity
+
+	// ReclaimedSize is the known size of the expired backups whose data is
+	// deleted from object storage.
+	ReclaimedSize resource.Quantity
+
+	// OrphanedSize is the known size of the expired backups whose data is
+	// kept in object storage.
+	OrphanedSize resource.Quantity
+
+	// ProjectedCount is the number of backups the schedule retains at once
+	// in steady state, i.e. the runs within one TTL. It is nil if the
+	// schedule's backups don't expire, or the schedule is suspended.
+	ProjectedCount *int
+
+	// ProjectedSize is the ProjectedCount times the average known size of
+	// the schedule's backups. It is nil if either is unknown.
+	ProjectedSize *resource.Quantity
+}
+
+// PlanBackupRetention plans the retention of the schedule's backups, i.e. the
+// backups in its namespace labelled with its name. Backups expire their TTL
+// after they were created, and never if they have no TTL. Backups that were
+// already deleted from object storage are ignored.
+func PlanBackupRetention(schedule *BackupSchedule, backups *BackupList, o BackupRetentionOptions) (*BackupRetentionPlan, error) {
+	now := o.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	plan := &BackupRetentionPlan{}
+
+	owned := make([]Backup, 0, len(backups.Items))
+	for _, b := range backups.Items {
+		if b.GetNamespace() != schedule.GetNamespace() || b.GetLabels()[BackupScheduleLabelKey] != schedule.GetName() {
+			continue
+		}
+		if b.Status.Phase == BackupPhaseDeleted {
+			continue
+		}
+		owned = append(owned, b)
+	}
+	sort.SliceStable(owned, func(i, j int) bool {
+		return owned[i].CreationTimestamp.Before(&owned[j].CreationTimestamp)
+	})
+
+	var total resource.Quantity
+	sized := 0
+	for _, b := range owned {
+		r := BackupRetention{Name: b.GetName(), DeletionPolicy: b.Spec.DeletionPolicy}
+		if r.DeletionPolicy == "" {
+			r.DeletionPolicy = xpv1.DeletionOrphan
+		}
+		if b.Spec.TTL != nil {
+			exp := b.GetCreationTimestamp().Add(b.Spec.TTL.Duration)
+			r.ExpiresAt = &exp
+		}
+		if q, ok := o.Sizes[b.GetName()]; ok {
+			q := q.DeepCopy()
+			r.Size = &q
+			total.Add(q)
+			sized++
+		}
+
+		switch {
+		case r.ExpiresAt != nil && !r.ExpiresAt.After(now):
+			plan.Expired = append(plan.Expired, r)
+			d := BackupDeletion{Name: r.Name, DeleteData: r.DeletionPolicy == xpv1.DeletionDelete}
+			plan.Deletions = append(plan.Deletions, d)
+			if r.Size == nil {
+				continue
+			}
+			if d.DeleteData {
+				plan.ReclaimedSize.Add(*r.Size)
+			} else {
+				plan.OrphanedSize.Add(*r.Size)
+			}
+		case r.ExpiresAt != nil && r.ExpiresAt.Before(now.Add(o.ExpiringWithin)):
+			plan.Expiring = append(plan.Expiring, r)
+			if r.Size != nil {
+				plan.RetainedSize.Add(*r.Size)
+			}
+		default:
+			plan.Retained = append(plan.Retained, r)
+			if r.Size != nil {
+				plan.RetainedSize.Add(*r.Size)
+			}
+		}
+	}
+
+	if schedule.Spec.TTL == nil || schedule.Spec.Suspend {
+		return plan, nil
+	}
+	n, err := schedule.Spec.runsWithin(now, schedule.Spec.TTL.Duration)
+	if err != nil {
+		return nil, err
+	}
+	plan.ProjectedCount = &n
+	if sized > 0 {
+		avg := total.AsApproximateFloat64() / float64(sized)
+		plan.ProjectedSize = resource.NewQuantity(int64(avg*float64(n)), resource.BinarySI)
+	}
+	return plan, nil
+}
+
+// runsWithin counts the runs of the schedule within dur after from.
+func (d *BackupScheduleDefinition) runsWithin(from time.Time, dur time.Duration) (int, error) {
+	c, err := ParseCronSchedule(d.Schedule)
+	if err != nil {
+		return 0, err
+	}
+	end := from.Add(dur)
+	n := 0
+	for t := from; n < maxProjectedRuns; n++ {
+		next, ok := c.Next(t)
+		if !ok || !next.Before(end) {
+			break
+		}
+		t = next
+	}
+	return n, nil
+}
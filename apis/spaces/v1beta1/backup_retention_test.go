@@ -0,0 +1,182 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+func TestPlanBackupRetention(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	ttl := &metav1.Duration{Duration: 3 * day}
+
+	schedule := func(suspend bool, ttl *metav1.Duration) *BackupSchedule {
+		return &BackupSchedule{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "daily"},
+			Spec: BackupScheduleSpec{
+				BackupScheduleDefinition: BackupScheduleDefinition{
+					Schedule:         "@daily",
+					Suspend:          suspend,
+					BackupDefinition: BackupDefinition{TTL: ttl},
+				},
+			},
+		}
+	}
+	backup := func(name, scheduleName string, age time.Duration, ttl *metav1.Duration, p xpv1.DeletionPolicy) Backup {
+		return Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "default",
+				Name:              name,
+				Labels:            map[string]string{BackupScheduleLabelKey: scheduleName},
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Spec: BackupSpec{BackupDefinition: BackupDefinition{TTL: ttl, DeletionPolicy: p}},
+		}
+	}
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+	q := func(s string) *resource.Quantity {
+		v := resource.MustParse(s)
+		return &v
+	}
+	count := func(n int) *int { return &n }
+
+	deleted := backup("deleted", "daily", 5*day, ttl, xpv1.DeletionDelete)
+	deleted.Status.Phase = BackupPhaseDeleted
+	other := backup("other-namespace", "daily", 5*day, ttl, xpv1.DeletionDelete)
+	other.SetNamespace("other")
+
+	type args struct {
+		schedule *BackupSchedule
+		backups  []Backup
+		o        BackupRetentionOptions
+	}
+	cases := map[string]struct {
+		reason  string
+		args    args
+		want    *BackupRetentionPlan
+		wantErr bool
+	}{
+		"Plan": {
+			reason: "Backups of the schedule should be classified by their expiry, and only the data of expired backups with deletion policy Delete should be deleted.",
+			args: args{
+				schedule: schedule(false, ttl),
+				backups: []Backup{
+					backup("retained", "daily", day, ttl, ""),
+					backup("expired-orphan", "daily", 4*day, ttl, xpv1.DeletionOrphan),
+					backup("expiring", "daily", 2*day+12*time.Hour, ttl, xpv1.DeletionDelete),
+					backup("expired-delete", "daily", 5*day, ttl, xpv1.DeletionDelete),
+					backup("forever", "daily", 30*day, nil, xpv1.DeletionDelete),
+					backup("unrelated", "weekly", 30*day, ttl, xpv1.DeletionDelete),
+					deleted,
+					other,
+				},
+				o: BackupRetentionOptions{
+					Now:            now,
+					ExpiringWithin: day,
+					Sizes: map[string]resource.Quantity{
+						"retained":       resource.MustParse("1Gi"),
+						"expired-orphan": resource.MustParse("2Gi"),
+						"expiring":       resource.MustParse("3Gi"),
+						"expired-delete": resource.MustParse("2Gi"),
+					},
+				},
+			},
+			want: &BackupRetentionPlan{
+				Expired: []BackupRetention{
+					{Name: "expired-delete", ExpiresAt: at(-2 * day), DeletionPolicy: xpv1.DeletionDelete, Size: q("2Gi")},
+					{Name: "expired-orphan", ExpiresAt: at(-day), DeletionPolicy: xpv1.DeletionOrphan, Size: q("2Gi")},
+				},
+				Expiring: []BackupRetention{
+					{Name: "expiring", ExpiresAt: at(12 * time.Hour), DeletionPolicy: xpv1.DeletionDelete, Size: q("3Gi")},
+				},
+				Retained: []BackupRetention{
+					{Name: "forever", DeletionPolicy: xpv1.DeletionDelete},
+					{Name: "retained", ExpiresAt: at(2 * day), DeletionPolicy: xpv1.DeletionOrphan, Size: q("1Gi")},
+				},
+				Deletions: []BackupDeletion{
+					{Name: "expired-delete", DeleteData: true},
+					{Name: "expired-orphan", DeleteData: false},
+				},
+				RetainedSize:   *q("4Gi"),
+				ReclaimedSize:  *q("2Gi"),
+				OrphanedSize:   *q("2Gi"),
+				ProjectedCount: count(3),
+				ProjectedSize:  q("6Gi"),
+			},
+		},
+		"NoTTL": {
+			reason: "The footprint of a schedule whose backups never expire should not be projected.",
+			args: args{
+				schedule: schedule(false, nil),
+				backups:  []Backup{backup("forever", "daily", day, nil, "")},
+				o:        BackupRetentionOptions{Now: now},
+			},
+			want: &BackupRetentionPlan{
+				Retained: []BackupRetention{{Name: "forever", DeletionPolicy: xpv1.DeletionOrphan}},
+			},
+		},
+		"Suspended": {
+			reason: "The footprint of a suspended schedule should not be projected.",
+			args: args{
+				schedule: schedule(true, ttl),
+				o:        BackupRetentionOptions{Now: now},
+			},
+			want: &BackupRetentionPlan{},
+		},
+		"NoSizes": {
+			reason: "The projected size should be unknown if no backup sizes are supplied.",
+			args: args{
+				schedule: schedule(false, ttl),
+				o:        BackupRetentionOptions{Now: now},
+			},
+			want: &BackupRetentionPlan{ProjectedCount: count(3)},
+		},
+		"InvalidSchedule": {
+			reason: "An invalid schedule should return an error.",
+			args: args{
+				schedule: &BackupSchedule{Spec: BackupScheduleSpec{
+					BackupScheduleDefinition: BackupScheduleDefinition{
+						Schedule:         "not a schedule",
+						BackupDefinition: BackupDefinition{TTL: ttl},
+					},
+				}},
+				o: BackupRetentionOptions{Now: now},
+			},
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := PlanBackupRetention(tc.args.schedule, &BackupList{Items: tc.args.backups}, tc.args.o)
+			if diff := cmp.Diff(tc.wantErr, err != nil); diff != "" {
+				t.Fatalf("\n%s\nPlanBackupRetention(...): -want error, +got error:\n%s\n%v", tc.reason, diff, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.Comparer(func(a, b resource.Quantity) bool { return a.Cmp(b) == 0 })); diff != "" {
+				t.Errorf("\n%s\nPlanBackupRetention(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// cronSearchLimit bounds the search for the next run of a schedule, so that
+// schedules that never run, such as "0 0 30 2 *", terminate.
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	cronMonths = map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+	cronWeekdays = map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+)
+
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var cronFields = [5]cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: cronMonths},
+	// 7 is accepted as Sunday, like most cron implementations.
+	{name: "day of week", min: 0, max: 7, names: cronWeekdays},
+}
+
+// CronSchedule is a parsed cron schedule.
+// +kubebuilder:object:generate=false
+// +k8s:conversion-gen=false
+type CronSchedule struct {
+	loc *time.Location
+
+	minute, hour, dom, month, dow uint64
+
+	// domStar and dowStar are true if the day of month or day of week are
+	// unrestricted. If both are restricted a day matching either runs.
+	domStar, dowStar bool
+}
+
+// ParseCronSchedule parses a standard 5 field cron schedule, or one of the
+// @yearly, @annually, @monthly, @weekly, @daily, @midnight and @hourly
+// descriptors. The schedule may be prefixed by CRON_TZ=<zone> or TZ=<zone> to
+// run in the supplied time zone rather than UTC.
+func ParseCronSchedule(s string) (*CronSchedule, error) {
+	c := &CronSchedule{loc: time.UTC}
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "CRON_TZ=") || strings.HasPrefix(s, "TZ=") {
+		tz, rest, _ := strings.Cut(s, " ")
+		_, zone, _ := strings.Cut(tz, "=")
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", zone, err)
+		}
+		c.loc, s = loc, strings.TrimSpace(rest)
+	}
+	if strings.HasPrefix(s, "@") {
+		expr, ok := cronDescriptors[strings.ToLower(s)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported descriptor %q", s)
+		}
+		s = expr
+	}
+
+	parts := strings.Fields(s)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("expected %d fields, found %d", len(cronFields), len(parts))
+	}
+	bits := make([]uint64, len(cronFields))
+	for i, p := range parts {
+		b, err := parseCronField(p, cronFields[i])
+		if err != nil {
+			return nil, err
+		}
+		bits[i] = b
+	}
+	c.minute, c.hour, c.dom, c.month, c.dow = bits[0], bits[1], bits[2], bits[3], bits[4]
+	// Fold Sunday as 7 into Sunday as 0.
+	if c.dow&(1<<7) != 0 {
+		c.dow = c.dow&^(1<<7) | 1
+	}
+	c.domStar = parts[2] == "*" || parts[2] == "?"
+	c.dowStar = parts[4] == "*" || parts[4] == "?"
+	return c, nil
+}
+
+// parseCronField parses a comma separated list of values, ranges and steps
+// into a bit set.
+func parseCronField(s string, f cronField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(s, ",") {
+		expr, stepStr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepStr)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepStr, f.name)
+			}
+			step = n
+		}
+
+		lo, hi := f.min, f.max
+		switch {
+		case expr == "*" || expr == "?":
+		case strings.Contains(expr, "-"):
+			l, h, _ := strings.Cut(expr, "-")
+			var err error
+			if lo, err = parseCronValue(l, f); err != nil {
+				return 0, err
+			}
+			if hi, err = parseCronValue(h, f); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q in %s field", expr, f.name)
+			}
+		default:
+			v, err := parseCronValue(expr, f)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			// A single value with a step, e.g. 5/15, runs from the value
+			// to the end of the range.
+			if !hasStep {
+				hi = v
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(s string, f cronField) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", s, f.name)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d] in %s field", v, f.min, f.max, f.name)
+	}
+	return v, nil
+}
+
+// Next returns the first time after t the schedule runs, in the schedule's
+// time zone. It returns false if the schedule does not run within the next
+// five years.
+func (c *CronSchedule) Next(t time.Time) (time.Time, bool) {
+	t = t.In(c.loc)
+	limit := t.Add(cronSearchLimit)
+	// Schedules run at the start of a minute, strictly after t.
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	for t.Before(limit) {
+		var next time.Time
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			next = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, c.loc)
+		case !c.dayMatches(t):
+			next = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, c.loc)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			next = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, c.loc)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			next = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+		// Wall clock times repeated when daylight saving time ends resolve
+		// to their first occurrence, which may be before t.
+		if !next.After(t) {
+			next = t.Add(time.Minute)
+		}
+		t = next
+	}
+	return time.Time{}, false
+}
+
+func (c *CronSchedule) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Validate returns an error if the schedule is not a valid cron schedule.
+func (d *BackupScheduleDefinition) Validate() field.ErrorList {
+	if _, err := ParseCronSchedule(d.Schedule); err != nil {
+		return field.ErrorList{field.Invalid(field.NewPath("spec", "schedule"), d.Schedule, err.Error())}
+	}
+	return nil
+}
+
+// NextRuns returns up to n times after from the schedule runs. Fewer are
+// returned if the schedule does not run within five years. Suspended schedules
+// don't run.
+func (d *BackupScheduleDefinition) NextRuns(from time.Time, n int) ([]time.Time, error) {
+	c, err := ParseCronSchedule(d.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	if d.Suspend || n <= 0 {
+		return nil, nil
+	}
+	runs := make([]time.Time, 0, n)
+	for t := from; len(runs) < n; {
+		next, ok := c.Next(t)
+		if !ok {
+			break
+		}
+		runs = append(runs, next)
+		t = next
+	}
+	return runs, nil
+}
+
+// Overdue returns true if the schedule should have run after last, allowing
+// for the supplied grace period, but has not as of now. Suspended schedules
+// are never overdue.
+func (d *BackupScheduleDefinition) Overdue(last, now time.Time, grace time.Duration) (bool, error) {
+	runs, err := d.NextRuns(last, 1)
+	if err != nil || len(runs) == 0 {
+		return false, err
+	}
+	return now.After(runs[0].Add(grace)), nil
+}
+
+// Overdue returns true if the schedule should have created a Backup since its
+// last Backup, or since it was created if there is none, allowing for the
+// supplied grace period.
+func (s *BackupSchedule) Overdue(now time.Time, grace time.Duration) (bool, error) {
+	last := s.GetCreationTimestamp().Time
+	if s.Status.LastBackup != nil {
+		last = s.Status.LastBackup.Time
+	}
+	return s.Spec.Overdue(last, now, grace)
+}
@@ -0,0 +1,188 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNextRuns(t *testing.T) {
+	// A Wednesday.
+	from := time.Date(2025, 1, 1, 10, 30, 15, 0, time.UTC)
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	cases := map[string]struct {
+		reason   string
+		schedule string
+		suspend  bool
+		n        int
+		want     []time.Time
+		wantErr  bool
+	}{
+		"Hourly": {
+			reason:   "The @hourly descriptor should run at the start of every hour.",
+			schedule: "@hourly",
+			n:        2,
+			want:     []time.Time{time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)},
+		},
+		"Daily": {
+			reason:   "The @daily descriptor should run at midnight.",
+			schedule: "@daily",
+			n:        1,
+			want:     []time.Time{time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		},
+		"Steps": {
+			reason:   "Steps should run at every nth value.",
+			schedule: "*/20 10-11 * * *",
+			n:        4,
+			want: []time.Time{
+				time.Date(2025, 1, 1, 10, 40, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 11, 20, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 11, 40, 0, 0, time.UTC),
+			},
+		},
+		"Weekdays": {
+			reason:   "Named days of week should be supported.",
+			schedule: "0 9 * * sat,sun",
+			n:        2,
+			want:     []time.Time{time.Date(2025, 1, 4, 9, 0, 0, 0, time.UTC), time.Date(2025, 1, 5, 9, 0, 0, 0, time.UTC)},
+		},
+		"DayOfMonthOrWeek": {
+			reason:   "A day matching either a restricted day of month or day of week should run.",
+			schedule: "0 0 3 * 5,7",
+			n:        3,
+			want: []time.Time{
+				time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"TimeZone": {
+			reason:   "Schedules should run in the time zone of their prefix.",
+			schedule: "CRON_TZ=Europe/Berlin 0 2 * * *",
+			n:        1,
+			want:     []time.Time{time.Date(2025, 1, 2, 2, 0, 0, 0, berlin)},
+		},
+		"Never": {
+			reason:   "Schedules that never run should not return runs.",
+			schedule: "0 0 30 feb *",
+			n:        1,
+			want:     []time.Time{},
+		},
+		"Suspended": {
+			reason:   "Suspended schedules should not run.",
+			schedule: "@hourly",
+			suspend:  true,
+			n:        1,
+		},
+		"Invalid": {
+			reason:   "Invalid schedules should return an error.",
+			schedule: "61 * * * *",
+			n:        1,
+			wantErr:  true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := &BackupScheduleDefinition{Schedule: tc.schedule, Suspend: tc.suspend}
+			got, err := d.NextRuns(from, tc.n)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\nNextRuns(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateApproxTime(0), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nNextRuns(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBackupScheduleDefinitionValidate(t *testing.T) {
+	cases := map[string]struct {
+		schedule string
+		want     string
+	}{
+		"Valid":           {schedule: "TZ=UTC 0 */6 * jan-jun mon", want: ""},
+		"TooFewFields":    {schedule: "0 * * *", want: `spec.schedule: Invalid value: "0 * * *": expected 5 fields, found 4`},
+		"OutOfRange":      {schedule: "0 24 * * *", want: `spec.schedule: Invalid value: "0 24 * * *": value 24 out of range [0, 23] in hour field`},
+		"BadStep":         {schedule: "*/0 * * * *", want: `spec.schedule: Invalid value: "*/0 * * * *": invalid step "0" in minute field`},
+		"BadDescriptor":   {schedule: "@every 1h", want: `spec.schedule: Invalid value: "@every 1h": unsupported descriptor "@every 1h"`},
+		"BadRange":        {schedule: "0 0 * * fri-mon", want: `spec.schedule: Invalid value: "0 0 * * fri-mon": invalid range "fri-mon" in day of week field`},
+		"UnknownTimeZone": {schedule: "CRON_TZ=Mars/Olympus 0 0 * * *", want: `spec.schedule: Invalid value: "CRON_TZ=Mars/Olympus 0 0 * * *": invalid time zone "Mars/Olympus": unknown time zone Mars/Olympus`},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := &BackupScheduleDefinition{Schedule: tc.schedule}
+			got := ""
+			if errs := d.Validate(); len(errs) > 0 {
+				got = errs.ToAggregate().Error()
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Validate(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackupScheduleOverdue(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 30, 0, 0, time.UTC)
+	last := metav1.NewTime(time.Date(2025, 1, 1, 5, 0, 0, 0, time.UTC))
+	cases := map[string]struct {
+		reason string
+		status BackupScheduleStatus
+		now    time.Time
+		want   bool
+	}{
+		"NeverRanOverdue": {
+			reason: "A schedule that never ran should be overdue after its first run since creation.",
+			now:    time.Date(2025, 1, 1, 1, 10, 0, 0, time.UTC),
+			want:   true,
+		},
+		"WithinGrace": {
+			reason: "A schedule should not be overdue within the grace period.",
+			status: BackupScheduleStatus{LastBackup: &last},
+			now:    time.Date(2025, 1, 1, 6, 4, 0, 0, time.UTC),
+		},
+		"Overdue": {
+			reason: "A schedule should be overdue if its next run since the last backup has passed.",
+			status: BackupScheduleStatus{LastBackup: &last},
+			now:    time.Date(2025, 1, 1, 6, 6, 0, 0, time.UTC),
+			want:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := &BackupSchedule{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+				Spec:       BackupScheduleSpec{BackupScheduleDefinition: BackupScheduleDefinition{Schedule: "@hourly"}},
+				Status:     tc.status,
+			}
+			got, err := s.Overdue(tc.now, 5*time.Minute)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nOverdue(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var errNoClassFits = errors.New("no class has enough capacity for the resource usage")
+
+// Quantities parses the resource usage. Resources without usage are omitted.
+func (u *ResourceUsage) Quantities() (corev1.ResourceList, error) {
+	out := corev1.ResourceList{}
+	for _, r := range []struct {
+		name  corev1.ResourceName
+		value string
+	}{
+		{corev1.ResourceCPU, u.CPU},
+		{corev1.ResourceMemory, u.Memory},
+		{corev1.ResourceStorage, u.Storage},
+	} {
+		if r.value == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(r.value)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %s usage %q: %w", r.name, r.value, err)
+		}
+		out[r.name] = q
+	}
+	return out, nil
+}
+
+// ClassChange is a transition of a control plane from one class to another.
+// +kubebuilder:object:generate=false
+type ClassChange struct {
+	// From is the class before the transition. It is empty if unknown.
+	From string
+
+	// To is the class after the transition.
+	To string
+
+	// At is the time the transition was observed.
+	At time.Time
+}
+
+// ClassHistorySummary summarises the class transitions of a control plane
+// within a time window.
+// +kubebuilder:object:generate=false
+type ClassHistorySummary struct {
+	// From is the start of the window.
+	From time.Time
+
+	// To is the end of the window.
+	To time.Time
+
+	// TimeInClass is the time the control plane spent in each class within
+	// the window.
+	TimeInClass map[string]time.Duration
+
+	// Unaccounted is the time within the window the class of the control
+	// plane is unknown for, i.e. before its earliest recorded transition.
+	Unaccounted time.Duration
+
+	// Transitions are the transitions observed within the window, oldest
+	// first.
+	Transitions []ClassChange
+
+	// LastUpgrade is the last transition within the window to a larger
+	// class, if any.
+	LastUpgrade *ClassChange
+
+	// LastDowngrade is the last transition within the window to a smaller
+	// class, if any.
+	LastDowngrade *ClassChange
+}
+
+// SummarizeHistory summarises the class transitions of the control plane
+// within the window [from, to). Classes are ordered from smallest to largest
+// by order to tell upgrades from downgrades. Transitions involving classes
+// that are not ordered are neither. If there is no history, the control plane
+// is assumed to have been in its current class for the whole window.
+func (s *ControlPlaneClassStatus) SummarizeHistory(from, to time.Time, order []string) ClassHistorySummary {
+	sum := ClassHistorySummary{From: from, To: to, TimeInClass: map[string]time.Duration{}}
+	if !to.After(from) {
+		return sum
+	}
+
+	history := make([]ClassChange, 0, len(s.History))
+	for _, t := range s.History {
+		if t == nil || t.ObservedAt == nil {
+			continue
+		}
+		history = append(history, ClassChange{To: t.Class, At: t.ObservedAt.Time})
+	}
+	sort.SliceStable(history, func(i, j int) bool { return history[i].At.Before(history[j].At) })
+	for i := 1; i < len(history); i++ {
+		history[i].From = history[i-1].To
+	}
+	if len(history) == 0 && s.Current != "" {
+		sum.TimeInClass[s.Current] = to.Sub(from)
+		return sum
+	}
+
+	rank := make(map[string]int, len(order))
+	for i, c := range order {
+		rank[c] = i
+	}
+	cursor, class := from, ""
+	up, down := -1, -1
+	for _, c := range history {
+		if !c.At.After(from) {
+			class = c.To
+			continue
+		}
+		if !c.At.Before(to) {
+			break
+		}
+		sum.account(class, c.At.Sub(cursor))
+		cursor, class = c.At, c.To
+		sum.Transitions = append(sum.Transitions, c)
+
+		f, fok := rank[c.From]
+		t, tok := rank[c.To]
+		switch {
+		case !fok || !tok:
+			// Unordered classes are neither upgraded nor downgraded.
+		case t > f:
+			up = len(sum.Transitions) - 1
+		case t < f:
+			down = len(sum.Transitions) - 1
+		}
+	}
+	sum.account(class, to.Sub(cursor))
+	if up >= 0 {
+		sum.LastUpgrade = &sum.Transitions[up]
+	}
+	if down >= 0 {
+		sum.LastDowngrade = &sum.Transitions[down]
+	}
+	return sum
+}
+
+func (s *ClassHistorySummary) account(class string, d time.Duration) {
+	if class == "" {
+		s.Unaccounted += d
+		return
+	}
+	s.TimeInClass[class] += d
+}
+
+// ClassCapacity is the resource usage a control plane class can sustain.
+// +kubebuilder:object:generate=false
+type ClassCapacity struct {
+	// Class is the name of the class.
+	Class string
+
+	// Capacity is the usage the class can sustain. Resources that are not
+	// listed are not limited.
+	Capacity corev1.ResourceList
+}
+
+// RecommendClass returns the first of the classes, ordered from smallest to
+// largest, that can sustain the usage without any resource exceeding the
+// supplied fraction of its capacity. A maxUtilization of zero or less is
+// treated as 1, i.e. the full capacity.
+func RecommendClass(usage corev1.ResourceList, classes []ClassCapacity, maxUtilization float64) (string, error) {
+	if maxUtilization <= 0 {
+		maxUtilization = 1
+	}
+	for _, c := range classes {
+		if fits(usage, c.Capacity, maxUtilization) {
+			return c.Class, nil
+		}
+	}
+	return "", errNoClassFits
+}
+
+func fits(usage, capacity corev1.ResourceList, maxUtilization float64) bool {
+	for name, used := range usage {
+		limit, ok := capacity[name]
+		if !ok {
+			continue
+		}
+		if used.AsApproximateFloat64() > limit.AsApproximateFloat64()*maxUtilization {
+			return false
+		}
+	}
+	return true
+}
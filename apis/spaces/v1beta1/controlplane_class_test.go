@@ -0,0 +1,187 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResourceUsageQuantities(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		usage   ResourceUsage
+		want    corev1.ResourceList
+		wantErr bool
+	}{
+		"Parsed": {
+			reason: "Set usages should be parsed and empty ones omitted.",
+			usage:  ResourceUsage{CPU: "1500m", Memory: "2Gi"},
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1500m"),
+				corev1.ResourceMemory: resource.MustParse("2Gi"),
+			},
+		},
+		"Invalid": {
+			reason:  "Invalid quantities should return an error.",
+			usage:   ResourceUsage{Storage: "lots"},
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.usage.Quantities()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("\n%s\nQuantities(): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nQuantities(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSummarizeHistory(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(h int) *metav1.Time {
+		mt := metav1.NewTime(t0.Add(time.Duration(h) * time.Hour))
+		return &mt
+	}
+	order := []string{"small", "default", "large"}
+
+	cases := map[string]struct {
+		reason   string
+		status   ControlPlaneClassStatus
+		from, to time.Time
+		want     ClassHistorySummary
+	}{
+		"NoHistory": {
+			reason: "Without history the current class should account for the whole window.",
+			status: ControlPlaneClassStatus{Current: "default"},
+			from:   t0,
+			to:     t0.Add(10 * time.Hour),
+			want: ClassHistorySummary{
+				From: t0, To: t0.Add(10 * time.Hour),
+				TimeInClass: map[string]time.Duration{"default": 10 * time.Hour},
+			},
+		},
+		"Transitions": {
+			reason: "Time should be accounted per class, and the last upgrade and downgrade within the window returned.",
+			status: ControlPlaneClassStatus{
+				Current: "default",
+				History: []*ClassTransition{
+					{Class: "default", ObservedAt: at(8)},
+					{Class: "small", ObservedAt: at(0)},
+					{Class: "large", ObservedAt: at(4)},
+					{Class: "custom", ObservedAt: at(12)},
+				},
+			},
+			from: t0.Add(2 * time.Hour),
+			to:   t0.Add(10 * time.Hour),
+			want: ClassHistorySummary{
+				From: t0.Add(2 * time.Hour), To: t0.Add(10 * time.Hour),
+				TimeInClass: map[string]time.Duration{"small": 2 * time.Hour, "large": 4 * time.Hour, "default": 2 * time.Hour},
+				Transitions: []ClassChange{
+					{From: "small", To: "large", At: t0.Add(4 * time.Hour)},
+					{From: "large", To: "default", At: t0.Add(8 * time.Hour)},
+				},
+				LastUpgrade:   &ClassChange{From: "small", To: "large", At: t0.Add(4 * time.Hour)},
+				LastDowngrade: &ClassChange{From: "large", To: "default", At: t0.Add(8 * time.Hour)},
+			},
+		},
+		"Unaccounted": {
+			reason: "Time before the earliest transition should be unaccounted, and unordered classes neither upgraded nor downgraded.",
+			status: ControlPlaneClassStatus{
+				History: []*ClassTransition{
+					{Class: "small", ObservedAt: at(2)},
+					{Class: "custom", ObservedAt: at(3)},
+				},
+			},
+			from: t0,
+			to:   t0.Add(4 * time.Hour),
+			want: ClassHistorySummary{
+				From: t0, To: t0.Add(4 * time.Hour),
+				TimeInClass: map[string]time.Duration{"small": time.Hour, "custom": time.Hour},
+				Unaccounted: 2 * time.Hour,
+				Transitions: []ClassChange{
+					{To: "small", At: t0.Add(2 * time.Hour)},
+					{From: "small", To: "custom", At: t0.Add(3 * time.Hour)},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.status.SummarizeHistory(tc.from, tc.to, order)
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nSummarizeHistory(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRecommendClass(t *testing.T) {
+	classes := []ClassCapacity{
+		{Class: "small", Capacity: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("4Gi")}},
+		{Class: "default", Capacity: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourceMemory: resource.MustParse("8Gi")}},
+		{Class: "large", Capacity: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")}},
+	}
+	cases := map[string]struct {
+		reason         string
+		usage          corev1.ResourceList
+		maxUtilization float64
+		want           string
+		wantErr        error
+	}{
+		"Smallest": {
+			reason: "The smallest class with enough capacity should be recommended.",
+			usage:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1500m"), corev1.ResourceMemory: resource.MustParse("3Gi")},
+			want:   "small",
+		},
+		"Utilization": {
+			reason:         "Usage should not exceed the maximum utilization of a class.",
+			usage:          corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1500m"), corev1.ResourceMemory: resource.MustParse("3Gi")},
+			maxUtilization: 0.5,
+			want:           "default",
+		},
+		"Unlimited": {
+			reason: "Resources a class does not list should not limit it.",
+			usage:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("6"), corev1.ResourceMemory: resource.MustParse("64Gi")},
+			want:   "large",
+		},
+		"NoneFits": {
+			reason:  "An error should be returned if no class fits.",
+			usage:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("16")},
+			wantErr: errNoClassFits,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := RecommendClass(tc.usage, classes, tc.maxUtilization)
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRecommendClass(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nRecommendClass(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
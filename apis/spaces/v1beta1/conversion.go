@@ -0,0 +1,41 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import "sigs.k8s.io/controller-runtime/pkg/conversion"
+
+// The backup types are the conversion hubs of their v1alpha1 counterparts.
+var (
+	_ conversion.Hub = &Backup{}
+	_ conversion.Hub = &BackupSchedule{}
+	_ conversion.Hub = &SharedBackup{}
+	_ conversion.Hub = &SharedBackupConfig{}
+	_ conversion.Hub = &SharedBackupSchedule{}
+)
+
+// Hub marks this type as a conversion hub.
+func (*Backup) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*BackupSchedule) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*SharedBackup) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*SharedBackupConfig) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*SharedBackupSchedule) Hub() {}
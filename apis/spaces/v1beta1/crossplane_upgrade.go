@@ -0,0 +1,237 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+// CrossplaneUpgradeReason explains the target version of a Crossplane
+// upgrade.
+type CrossplaneUpgradeReason string
+
+const (
+	// CrossplaneUpgradeReasonPinned means the control plane is pinned to its
+	// version by the None channel.
+	CrossplaneUpgradeReasonPinned CrossplaneUpgradeReason = "Pinned"
+
+	// CrossplaneUpgradeReasonInstall means the control plane has no version of
+	// Crossplane yet.
+	CrossplaneUpgradeReasonInstall CrossplaneUpgradeReason = "Install"
+
+	// CrossplaneUpgradeReasonPatch means the control plane moves to a newer
+	// patch release of its minor version.
+	CrossplaneUpgradeReasonPatch CrossplaneUpgradeReason = "PatchUpgrade"
+
+	// CrossplaneUpgradeReasonMinor means the control plane moves to a newer
+	// minor version.
+	CrossplaneUpgradeReasonMinor CrossplaneUpgradeReason = "MinorUpgrade"
+
+	// CrossplaneUpgradeReasonUpToDate means the control plane already runs
+	// the target version of its channel, or a newer one. Control planes are
+	// never downgraded.
+	CrossplaneUpgradeReasonUpToDate CrossplaneUpgradeReason = "UpToDate"
+)
+
+var (
+	errNoAvailableVersions = errors.New("no Crossplane versions are available")
+	errPinnedNoVersion     = errors.New(`"version" cannot be empty when upgrade channel is "None"`)
+	errPatchNoVersion      = errors.New(`either "version" or the current version is required when upgrade channel is "Patch"`)
+)
+
+// CrossplaneReleaseManifest lists the Crossplane versions available to
+// control planes, e.g. as read from a JSON release manifest.
+// +kubebuilder:object:generate=false
+type CrossplaneReleaseManifest struct {
+	// Versions are the available versions of Universal Crossplane, without a
+	// leading 'v'.
+	Versions []string `json:"versions"`
+}
+
+// CrossplaneUpgrade is the version of Crossplane a control plane should
+// move to.
+// +kubebuilder:object:generate=false
+type CrossplaneUpgrade struct {
+	// Channel is the upgrade channel the target was resolved for.
+	Channel CrossplaneUpgradeChannel
+
+	// Current is the version the control plane runs, if any.
+	Current string
+
+	// Target is the version the control plane should run.
+	Target string
+
+	// Reason explains the target version.
+	Reason CrossplaneUpgradeReason
+
+	// Message explains the target version for humans.
+	Message string
+}
+
+// Channel returns the upgrade channel of the spec. It defaults to Stable.
+func (s *CrossplaneSpec) Channel() CrossplaneUpgradeChannel {
+	if s.AutoUpgradeSpec == nil || s.AutoUpgradeSpec.Channel == nil {
+		return CrossplaneUpgradeStable
+	}
+	return *s.AutoUpgradeSpec.Channel
+}
+
+// ResolveUpgrade computes the version of Crossplane a control plane running
+// the current version, if any, should move to given its spec and the
+// available versions. It returns an error for specs the channel can't be
+// resolved for, such as a version pinned with the Stable or Rapid channel.
+func (s *CrossplaneSpec) ResolveUpgrade(current string, available []string) (*CrossplaneUpgrade, error) { //nolint:gocyclo // A switch over channels.
+	ch := s.Channel()
+	pinned := ""
+	if s.Version != nil {
+		pinned = *s.Version
+	}
+	if pinned != "" && !semver.IsValid(canonical(pinned)) {
+		return nil, fmt.Errorf("version %q is not a valid semantic version", pinned)
+	}
+	if current != "" && !semver.IsValid(canonical(current)) {
+		return nil, fmt.Errorf("current version %q is not a valid semantic version", current)
+	}
+	versions, err := sortVersions(available)
+	if err != nil {
+		return nil, err
+	}
+	u := &CrossplaneUpgrade{Channel: ch, Current: current}
+
+	switch ch {
+	case CrossplaneUpgradeNone:
+		if pinned == "" {
+			return nil, errPinnedNoVersion
+		}
+		if !contains(versions, pinned) {
+			return nil, fmt.Errorf("pinned version %q is not available", pinned)
+		}
+		u.Target, u.Reason = pinned, CrossplaneUpgradeReasonPinned
+		u.Message = fmt.Sprintf("auto-upgrades are disabled, version is pinned to %s", pinned)
+		return u, nil
+
+	case CrossplaneUpgradePatch:
+		base := pinned
+		if current != "" {
+			base = current
+		}
+		if base == "" {
+			return nil, errPatchNoVersion
+		}
+		mm := semver.MajorMinor(canonical(base))
+		target := latest(versions, func(v string) bool { return semver.MajorMinor(canonical(v)) == mm })
+		if target == "" {
+			return nil, fmt.Errorf("no Crossplane versions are available for minor version %s", strings.TrimPrefix(mm, "v"))
+		}
+		return u.to(target, fmt.Sprintf("latest patch release of minor version %s", strings.TrimPrefix(mm, "v"))), nil
+
+	case CrossplaneUpgradeStable, CrossplaneUpgradeRapid:
+		if pinned != "" {
+			return nil, fmt.Errorf("%q must not be set when upgrade channel is %q", "version", ch)
+		}
+		if len(versions) == 0 {
+			return nil, errNoAvailableVersions
+		}
+		minors := minorVersions(versions)
+		mm, desc := minors[0], "latest minor version"
+		if ch == CrossplaneUpgradeStable && len(minors) > 1 {
+			mm, desc = minors[1], "minor version N-1"
+		}
+		target := latest(versions, func(v string) bool { return semver.MajorMinor(canonical(v)) == mm })
+		return u.to(target, fmt.Sprintf("latest patch release of %s %s", desc, strings.TrimPrefix(mm, "v"))), nil
+
+	default:
+		return nil, fmt.Errorf("unknown upgrade channel %q", ch)
+	}
+}
+
+// to sets the target of the upgrade, without downgrading the current
+// version.
+func (u *CrossplaneUpgrade) to(target, why string) *CrossplaneUpgrade {
+	switch {
+	case u.Current == "":
+		u.Target, u.Reason = target, CrossplaneUpgradeReasonInstall
+		u.Message = fmt.Sprintf("installing %s, the %s", target, why)
+	case semver.Compare(canonical(u.Current), canonical(target)) >= 0:
+		u.Target, u.Reason = u.Current, CrossplaneUpgradeReasonUpToDate
+		u.Message = fmt.Sprintf("%s is at or newer than %s, the %s", u.Current, target, why)
+	case semver.MajorMinor(canonical(u.Current)) == semver.MajorMinor(canonical(target)):
+		u.Target, u.Reason = target, CrossplaneUpgradeReasonPatch
+		u.Message = fmt.Sprintf("upgrading from %s to %s, the %s", u.Current, target, why)
+	default:
+		u.Target, u.Reason = target, CrossplaneUpgradeReasonMinor
+		u.Message = fmt.Sprintf("upgrading from %s to %s, the %s", u.Current, target, why)
+	}
+	return u
+}
+
+// canonical returns the version with the leading 'v' expected by the semver
+// package.
+func canonical(v string) string {
+	return "v" + strings.TrimPrefix(v, "v")
+}
+
+// sortVersions validates the versions and returns them without a leading
+// 'v', newest first.
+func sortVersions(vs []string) ([]string, error) {
+	out := make([]string, 0, len(vs))
+	for _, v := range vs {
+		if !semver.IsValid(canonical(v)) {
+			return nil, fmt.Errorf("available version %q is not a valid semantic version", v)
+		}
+		out = append(out, strings.TrimPrefix(v, "v"))
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return semver.Compare(canonical(out[i]), canonical(out[j])) > 0
+	})
+	return out, nil
+}
+
+// minorVersions returns the distinct minor versions of the sorted versions,
+// newest first.
+func minorVersions(sorted []string) []string {
+	var out []string
+	for _, v := range sorted {
+		mm := semver.MajorMinor(canonical(v))
+		if len(out) == 0 || out[len(out)-1] != mm {
+			out = append(out, mm)
+		}
+	}
+	return out
+}
+
+// latest returns the first of the sorted versions matching fn.
+func latest(sorted []string, fn func(string) bool) string {
+	for _, v := range sorted {
+		if fn(v) {
+			return v
+		}
+	}
+	return ""
+}
+
+func contains(vs []string, v string) bool {
+	for _, o := range vs {
+		if semver.Compare(canonical(o), canonical(v)) == 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+)
+
+const releaseManifest = `{"versions": ["1.18.3-up.1", "1.19.0-up.1", "1.19.2-up.1", "1.19.2-up.2", "1.20.0-up.1", "1.20.1-up.1", "1.18.5-up.1"]}`
+
+func TestResolveUpgrade(t *testing.T) {
+	m := &CrossplaneReleaseManifest{}
+	if err := json.Unmarshal([]byte(releaseManifest), m); err != nil {
+		t.Fatal(err)
+	}
+	spec := func(ch CrossplaneUpgradeChannel, version string) CrossplaneSpec {
+		s := CrossplaneSpec{AutoUpgradeSpec: &CrossplaneAutoUpgradeSpec{Channel: &ch}}
+		if version != "" {
+			s.Version = ptr.To(version)
+		}
+		return s
+	}
+	type want struct {
+		target string
+		reason CrossplaneUpgradeReason
+		err    string
+	}
+	cases := map[string]struct {
+		reason  string
+		spec    CrossplaneSpec
+		current string
+		want    want
+	}{
+		"DefaultStableInstall": {
+			reason: "The Stable channel should be the default and install the latest patch of minor version N-1.",
+			want:   want{target: "1.19.2-up.2", reason: CrossplaneUpgradeReasonInstall},
+		},
+		"StableMinorUpgrade": {
+			reason:  "The Stable channel should move older minor versions to minor version N-1.",
+			spec:    spec(CrossplaneUpgradeStable, ""),
+			current: "1.18.3-up.1",
+			want:    want{target: "1.19.2-up.2", reason: CrossplaneUpgradeReasonMinor},
+		},
+		"StableNoDowngrade": {
+			reason:  "The Stable channel should not downgrade control planes on the latest minor version.",
+			spec:    spec(CrossplaneUpgradeStable, ""),
+			current: "1.20.0-up.1",
+			want:    want{target: "1.20.0-up.1", reason: CrossplaneUpgradeReasonUpToDate},
+		},
+		"RapidPatchUpgrade": {
+			reason:  "The Rapid channel should move to the latest patch of the latest minor version.",
+			spec:    spec(CrossplaneUpgradeRapid, ""),
+			current: "1.20.0-up.1",
+			want:    want{target: "1.20.1-up.1", reason: CrossplaneUpgradeReasonPatch},
+		},
+		"PatchUpgrade": {
+			reason:  "The Patch channel should keep the minor version of the current version.",
+			spec:    spec(CrossplaneUpgradePatch, "1.18.3-up.1"),
+			current: "1.18.3-up.1",
+			want:    want{target: "1.18.5-up.1", reason: CrossplaneUpgradeReasonPatch},
+		},
+		"PatchUpToDate": {
+			reason:  "The Patch channel should keep control planes on the latest patch release.",
+			spec:    spec(CrossplaneUpgradePatch, ""),
+			current: "1.20.1-up.1",
+			want:    want{target: "1.20.1-up.1", reason: CrossplaneUpgradeReasonUpToDate},
+		},
+		"PatchNoVersion": {
+			reason: "The Patch channel should require a version to derive the minor version from.",
+			spec:   spec(CrossplaneUpgradePatch, ""),
+			want:   want{err: errPatchNoVersion.Error()},
+		},
+		"Pinned": {
+			reason:  "The None channel should keep the pinned version.",
+			spec:    spec(CrossplaneUpgradeNone, "1.19.0-up.1"),
+			current: "1.18.3-up.1",
+			want:    want{target: "1.19.0-up.1", reason: CrossplaneUpgradeReasonPinned},
+		},
+		"PinnedUnavailable": {
+			reason: "The None channel should reject versions that are not available.",
+			spec:   spec(CrossplaneUpgradeNone, "1.17.0-up.1"),
+			want:   want{err: `pinned version "1.17.0-up.1" is not available`},
+		},
+		"PinnedNoVersion": {
+			reason: "The None channel should require a version.",
+			spec:   spec(CrossplaneUpgradeNone, ""),
+			want:   want{err: errPinnedNoVersion.Error()},
+		},
+		"PinnedRapid": {
+			reason: "A version should not be pinned with the Rapid channel.",
+			spec:   spec(CrossplaneUpgradeRapid, "1.19.0-up.1"),
+			want:   want{err: `"version" must not be set when upgrade channel is "Rapid"`},
+		},
+		"InvalidVersion": {
+			reason:  "Invalid current versions should be rejected.",
+			spec:    spec(CrossplaneUpgradeRapid, ""),
+			current: "latest",
+			want:    want{err: `current version "latest" is not a valid semantic version`},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u, err := tc.spec.ResolveUpgrade(tc.current, m.Versions)
+			got := want{}
+			if err != nil {
+				got.err = err.Error()
+			}
+			if u != nil {
+				got.target, got.reason = u.Target, u.Reason
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nResolveUpgrade(%q): -want, +got:\n%s", tc.reason, tc.current, diff)
+			}
+		})
+	}
+}
+
+func ExampleCrossplaneSpec_ResolveUpgrade() {
+	spec := &CrossplaneSpec{}
+	u, err := spec.ResolveUpgrade("1.19.0-up.1", []string{"1.19.0-up.1", "1.19.1-up.1", "1.20.0-up.1"})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(u.Reason, u.Target)
+	fmt.Println(u.Message)
+	// Output:
+	// PatchUpgrade 1.19.1-up.1
+	// upgrading from 1.19.0-up.1 to 1.19.1-up.1, the latest patch release of minor version N-1 1.19
+}
@@ -0,0 +1,312 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+var (
+	s3BucketRegex       = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]*[a-z0-9]$`)
+	azureContainerRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*[a-z0-9]$`)
+	gcsBucketRegex      = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*[a-z0-9]$`)
+	azureAccountRegex   = regexp.MustCompile(`^[a-z0-9]{3,24}$`)
+	gcpProjectRegex     = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+)
+
+// AWSObjectStorageConfig is the config of AWS S3 or S3 compatible object
+// storage.
+// +kubebuilder:object:generate=false
+// +k8s:conversion-gen=false
+type AWSObjectStorageConfig struct {
+	// Region of the bucket. It is required unless an endpoint is set.
+	Region string `json:"region,omitempty"`
+
+	// Endpoint of an S3 compatible object storage, e.g. a MinIO host.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// ForcePathStyle addresses the bucket by path rather than by virtual
+	// host.
+	ForcePathStyle bool `json:"forcePathStyle,omitempty"`
+}
+
+// AzureObjectStorageConfig is the config of Azure Blob Storage.
+// +kubebuilder:object:generate=false
+// +k8s:conversion-gen=false
+type AzureObjectStorageConfig struct {
+	// StorageAccount is the name of the storage account.
+	StorageAccount string `json:"storageAccount"`
+
+	// Container is the name of the blob container. The bucket overrides it,
+	// so it must match the bucket if set.
+	Container string `json:"container,omitempty"`
+
+	// Endpoint of the storage service, e.g. blob.core.windows.net.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// GCPObjectStorageConfig is the config of Google Cloud Storage.
+// +kubebuilder:object:generate=false
+// +k8s:conversion-gen=false
+type GCPObjectStorageConfig struct {
+	// Project is the ID of the project the bucket belongs to.
+	Project string `json:"project,omitempty"`
+}
+
+// AWSConfig decodes the config of the object storage as AWS config.
+func (s *BackupObjectStorage) AWSConfig() (*AWSObjectStorageConfig, error) {
+	cfg := &AWSObjectStorageConfig{}
+	return cfg, s.decodeConfig(cfg)
+}
+
+// AzureConfig decodes the config of the object storage as Azure config.
+func (s *BackupObjectStorage) AzureConfig() (*AzureObjectStorageConfig, error) {
+	cfg := &AzureObjectStorageConfig{}
+	return cfg, s.decodeConfig(cfg)
+}
+
+// GCPConfig decodes the config of the object storage as GCP config.
+func (s *BackupObjectStorage) GCPConfig() (*GCPObjectStorageConfig, error) {
+	cfg := &GCPObjectStorageConfig{}
+	return cfg, s.decodeConfig(cfg)
+}
+
+// decodeConfig decodes the config into out. Unknown options are ignored, as
+// they are passed on to the object storage client.
+func (s *BackupObjectStorage) decodeConfig(out any) error {
+	if s.Config.Object == nil {
+		return nil
+	}
+	b, err := json.Marshal(s.Config.Object)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+// Validate returns the errors of the AWS config.
+func (c *AWSObjectStorageConfig) Validate(pth *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if c.Region == "" && c.Endpoint == "" {
+		errs = append(errs, field.Required(pth.Child("region"), "region is required unless an endpoint is set"))
+	}
+	if c.Endpoint != "" {
+		if err := validateEndpoint(c.Endpoint); err != nil {
+			errs = append(errs, field.Invalid(pth.Child("endpoint"), c.Endpoint, err.Error()))
+		}
+	}
+	return errs
+}
+
+// Validate returns the errors of the Azure config, whose container must match
+// the supplied bucket.
+func (c *AzureObjectStorageConfig) Validate(pth *field.Path, bucket string) field.ErrorList {
+	var errs field.ErrorList
+	switch {
+	case c.StorageAccount == "":
+		errs = append(errs, field.Required(pth.Child("storageAccount"), ""))
+	case !azureAccountRegex.MatchString(c.StorageAccount):
+		errs = append(errs, field.Invalid(pth.Child("storageAccount"), c.StorageAccount, "must be 3 to 24 lowercase letters and numbers"))
+	}
+	if c.Container != "" && c.Container != bucket {
+		errs = append(errs, field.Invalid(pth.Child("container"), c.Container, fmt.Sprintf("must match bucket %q, which overrides it", bucket)))
+	}
+	if c.Endpoint != "" {
+		if err := validateEndpoint(c.Endpoint); err != nil {
+			errs = append(errs, field.Invalid(pth.Child("endpoint"), c.Endpoint, err.Error()))
+		}
+	}
+	return errs
+}
+
+// Validate returns the errors of the GCP config.
+func (c *GCPObjectStorageConfig) Validate(pth *field.Path) field.ErrorList {
+	if c.Project != "" && !gcpProjectRegex.MatchString(c.Project) {
+		return field.ErrorList{field.Invalid(pth.Child("project"), c.Project, "must be 6 to 30 lowercase letters, numbers and hyphens, starting with a letter and not ending with a hyphen")}
+	}
+	return nil
+}
+
+// validateEndpoint accepts a host, optionally with a port, or an http or
+// https URL.
+func validateEndpoint(e string) error {
+	host := e
+	if strings.Contains(e, "://") {
+		u, err := url.Parse(e)
+		if err != nil {
+			return err
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("unsupported scheme %q, must be http or https", u.Scheme)
+		}
+		host = u.Host
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if host == "" || strings.ContainsAny(host, "/ ") {
+		return fmt.Errorf("must be a host, optionally with a port, or an http or https URL")
+	}
+	return nil
+}
+
+// ValidateBucketName returns why the bucket name is invalid for the provider,
+// if it is.
+func ValidateBucketName(p BackupObjectStorageProvider, bucket string) []string { //nolint:gocyclo // A switch over providers.
+	var msgs []string
+	switch p {
+	case BackupObjectStorageProviderAWS:
+		if len(bucket) < 3 || len(bucket) > 63 {
+			msgs = append(msgs, "must be between 3 and 63 characters")
+		}
+		if !s3BucketRegex.MatchString(bucket) {
+			msgs = append(msgs, "must consist of lowercase letters, numbers, dots and hyphens, and begin and end with a letter or number")
+		}
+		if strings.Contains(bucket, "..") {
+			msgs = append(msgs, "must not contain two adjacent dots")
+		}
+		if net.ParseIP(bucket) != nil {
+			msgs = append(msgs, "must not be formatted as an IP address")
+		}
+		if strings.HasPrefix(bucket, "xn--") || strings.HasPrefix(bucket, "sthree-") {
+			msgs = append(msgs, `must not begin with "xn--" or "sthree-"`)
+		}
+		if strings.HasSuffix(bucket, "-s3alias") || strings.HasSuffix(bucket, "--ol-s3") {
+			msgs = append(msgs, `must not end with "-s3alias" or "--ol-s3"`)
+		}
+	case BackupObjectStorageProviderAzure:
+		if len(bucket) < 3 || len(bucket) > 63 {
+			msgs = append(msgs, "must be between 3 and 63 characters")
+		}
+		if !azureContainerRegex.MatchString(bucket) {
+			msgs = append(msgs, "must consist of lowercase letters, numbers and hyphens, and begin and end with a letter or number")
+		}
+		if strings.Contains(bucket, "--") {
+			msgs = append(msgs, "must not contain consecutive hyphens")
+		}
+	case BackupObjectStorageProviderGCP:
+		// Names containing dots may be up to 222 characters, with each dot
+		// separated component up to 63.
+		maxLen := 63
+		if strings.Contains(bucket, ".") {
+			maxLen = 222
+		}
+		if len(bucket) < 3 || len(bucket) > maxLen {
+			msgs = append(msgs, fmt.Sprintf("must be between 3 and %d characters", maxLen))
+		}
+		for _, c := range strings.Split(bucket, ".") {
+			if len(c) > 63 {
+				msgs = append(msgs, "dot separated components must be at most 63 characters")
+				break
+			}
+		}
+		if !gcsBucketRegex.MatchString(bucket) {
+			msgs = append(msgs, "must consist of lowercase letters, numbers, dots, hyphens and underscores, and begin and end with a letter or number")
+		}
+		if net.ParseIP(bucket) != nil {
+			msgs = append(msgs, "must not be formatted as an IP address")
+		}
+		if strings.HasPrefix(bucket, "goog") || strings.Contains(bucket, "google") {
+			msgs = append(msgs, `must not begin with "goog" or contain "google"`)
+		}
+	}
+	return msgs
+}
+
+// ValidateProvider returns the errors of the provider, bucket and config of
+// the object storage.
+func (s *BackupObjectStorage) ValidateProvider(pth *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if s.Bucket == "" {
+		errs = append(errs, field.Required(pth.Child("bucket"), ""))
+	} else {
+		for _, msg := range ValidateBucketName(s.Provider, s.Bucket) {
+			errs = append(errs, field.Invalid(pth.Child("bucket"), s.Bucket, msg))
+		}
+	}
+
+	cpth := pth.Child("config")
+	switch s.Provider {
+	case BackupObjectStorageProviderAWS:
+		cfg, err := s.AWSConfig()
+		if err != nil {
+			return append(errs, field.Invalid(cpth, s.Config.String(), err.Error()))
+		}
+		errs = append(errs, cfg.Validate(cpth)...)
+	case BackupObjectStorageProviderAzure:
+		cfg, err := s.AzureConfig()
+		if err != nil {
+			return append(errs, field.Invalid(cpth, s.Config.String(), err.Error()))
+		}
+		errs = append(errs, cfg.Validate(cpth, s.Bucket)...)
+	case BackupObjectStorageProviderGCP:
+		cfg, err := s.GCPConfig()
+		if err != nil {
+			return append(errs, field.Invalid(cpth, s.Config.String(), err.Error()))
+		}
+		errs = append(errs, cfg.Validate(cpth)...)
+	default:
+		errs = append(errs, field.NotSupported(pth.Child("provider"), s.Provider, []BackupObjectStorageProvider{
+			BackupObjectStorageProviderAWS,
+			BackupObjectStorageProviderAzure,
+			BackupObjectStorageProviderGCP,
+		}))
+	}
+	return errs
+}
+
+// Validate returns the errors of the credentials. A Secret source requires
+// a secret reference, which other sources don't support.
+func (c *BackupCredentials) Validate(pth *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	switch c.Source {
+	case xpv1.CredentialsSourceSecret:
+		if c.SecretRef == nil {
+			return field.ErrorList{field.Required(pth.Child("secretRef"), "required when source is Secret")}
+		}
+		if c.SecretRef.Name == "" {
+			errs = append(errs, field.Required(pth.Child("secretRef", "name"), ""))
+		}
+	case xpv1.CredentialsSourceInjectedIdentity:
+		if c.SecretRef != nil {
+			errs = append(errs, field.Forbidden(pth.Child("secretRef"), "must not be set when source is InjectedIdentity"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(pth.Child("source"), c.Source, []xpv1.CredentialsSource{
+			xpv1.CredentialsSourceSecret,
+			xpv1.CredentialsSourceInjectedIdentity,
+		}))
+	}
+	return errs
+}
+
+// Validate returns the errors of the object storage.
+func (s *BackupObjectStorage) Validate(pth *field.Path) field.ErrorList {
+	return append(s.ValidateProvider(pth), s.Credentials.Validate(pth.Child("credentials"))...)
+}
+
+// Validate returns the errors of the SharedBackupConfig.
+func (c *SharedBackupConfig) Validate() field.ErrorList {
+	return c.Spec.ObjectStorage.Validate(field.NewPath("spec", "objectStorage"))
+}
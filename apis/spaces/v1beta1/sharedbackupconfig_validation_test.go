@@ -0,0 +1,196 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+)
+
+// errorFields returns the field and type of each error.
+func errorFields(errs field.ErrorList) []string {
+	var out []string
+	for _, e := range errs {
+		out = append(out, e.Field+": "+string(e.Type))
+	}
+	return out
+}
+
+func TestSharedBackupConfigValidate(t *testing.T) {
+	secret := BackupCredentials{
+		Source:                         xpv1.CredentialsSourceSecret,
+		LocalCommonCredentialSelectors: LocalCommonCredentialSelectors{SecretRef: &LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "creds"}, Key: "credentials"}},
+	}
+	config := func(m map[string]interface{}) common.JSONObject { return common.JSONObject{Object: m} }
+
+	cases := map[string]struct {
+		reason  string
+		storage BackupObjectStorage
+		want    []string
+	}{
+		"ValidAWS": {
+			reason: "A bucket in an AWS region with secret credentials should be valid.",
+			storage: BackupObjectStorage{
+				Provider:    BackupObjectStorageProviderAWS,
+				Bucket:      "my-backups.prod",
+				Config:      config(map[string]interface{}{"region": "us-west-2", "unknown": true}),
+				Credentials: secret,
+			},
+		},
+		"ValidS3Compatible": {
+			reason: "An S3 compatible endpoint should not require a region.",
+			storage: BackupObjectStorage{
+				Provider:    BackupObjectStorageProviderAWS,
+				Bucket:      "backups",
+				Config:      config(map[string]interface{}{"endpoint": "http://minio.minio:9000", "forcePathStyle": true}),
+				Credentials: secret,
+			},
+		},
+		"InvalidAWS": {
+			reason: "AWS bucket naming rules should be enforced, and a region or endpoint should be required.",
+			storage: BackupObjectStorage{
+				Provider:    BackupObjectStorageProviderAWS,
+				Bucket:      "My..Bucket",
+				Credentials: secret,
+			},
+			want: []string{
+				"spec.objectStorage.bucket: FieldValueInvalid",
+				"spec.objectStorage.bucket: FieldValueInvalid",
+				"spec.objectStorage.config.region: FieldValueRequired",
+			},
+		},
+		"InvalidAWSEndpoint": {
+			reason: "Endpoints should be hosts or http URLs.",
+			storage: BackupObjectStorage{
+				Provider:    BackupObjectStorageProviderAWS,
+				Bucket:      "backups",
+				Config:      config(map[string]interface{}{"endpoint": "ftp://minio"}),
+				Credentials: secret,
+			},
+			want: []string{"spec.objectStorage.config.endpoint: FieldValueInvalid"},
+		},
+		"UndecodableConfig": {
+			reason: "Config of the wrong type should be invalid.",
+			storage: BackupObjectStorage{
+				Provider:    BackupObjectStorageProviderAWS,
+				Bucket:      "backups",
+				Config:      config(map[string]interface{}{"region": 42}),
+				Credentials: secret,
+			},
+			want: []string{"spec.objectStorage.config: FieldValueInvalid"},
+		},
+		"ValidAzure": {
+			reason: "An Azure container whose config matches the bucket should be valid.",
+			storage: BackupObjectStorage{
+				Provider:    BackupObjectStorageProviderAzure,
+				Bucket:      "backups",
+				Config:      config(map[string]interface{}{"storageAccount": "upbackups", "container": "backups"}),
+				Credentials: BackupCredentials{Source: xpv1.CredentialsSourceInjectedIdentity},
+			},
+		},
+		"InvalidAzure": {
+			reason: "Azure container naming rules should be enforced, a storage account required and the container should match the bucket.",
+			storage: BackupObjectStorage{
+				Provider:    BackupObjectStorageProviderAzure,
+				Bucket:      "back--ups",
+				Config:      config(map[string]interface{}{"container": "other"}),
+				Credentials: secret,
+			},
+			want: []string{
+				"spec.objectStorage.bucket: FieldValueInvalid",
+				"spec.objectStorage.config.storageAccount: FieldValueRequired",
+				"spec.objectStorage.config.container: FieldValueInvalid",
+			},
+		},
+		"ValidGCP": {
+			reason: "A GCS bucket with underscores in a valid project should be valid.",
+			storage: BackupObjectStorage{
+				Provider:    BackupObjectStorageProviderGCP,
+				Bucket:      "my_backups",
+				Config:      config(map[string]interface{}{"project": "my-project-123"}),
+				Credentials: secret,
+			},
+		},
+		"InvalidGCP": {
+			reason: "GCS bucket naming rules and project IDs should be enforced.",
+			storage: BackupObjectStorage{
+				Provider:    BackupObjectStorageProviderGCP,
+				Bucket:      "google-backups",
+				Config:      config(map[string]interface{}{"project": "1project"}),
+				Credentials: secret,
+			},
+			want: []string{
+				"spec.objectStorage.bucket: FieldValueInvalid",
+				"spec.objectStorage.config.project: FieldValueInvalid",
+			},
+		},
+		"UnknownProvider": {
+			reason: "Unknown providers should not be supported.",
+			storage: BackupObjectStorage{
+				Provider:    "DigitalOcean",
+				Bucket:      "backups",
+				Credentials: secret,
+			},
+			want: []string{"spec.objectStorage.provider: FieldValueNotSupported"},
+		},
+		"SecretWithoutRef": {
+			reason: "A Secret source should require a secret reference.",
+			storage: BackupObjectStorage{
+				Provider:    BackupObjectStorageProviderAWS,
+				Bucket:      "backups",
+				Config:      config(map[string]interface{}{"region": "us-west-2"}),
+				Credentials: BackupCredentials{Source: xpv1.CredentialsSourceSecret},
+			},
+			want: []string{"spec.objectStorage.credentials.secretRef: FieldValueRequired"},
+		},
+		"InjectedIdentityWithRef": {
+			reason: "An InjectedIdentity source should not allow a secret reference.",
+			storage: BackupObjectStorage{
+				Provider: BackupObjectStorageProviderAWS,
+				Bucket:   "backups",
+				Config:   config(map[string]interface{}{"region": "us-west-2"}),
+				Credentials: BackupCredentials{
+					Source:                         xpv1.CredentialsSourceInjectedIdentity,
+					LocalCommonCredentialSelectors: secret.LocalCommonCredentialSelectors,
+				},
+			},
+			want: []string{"spec.objectStorage.credentials.secretRef: FieldValueForbidden"},
+		},
+		"UnsupportedSource": {
+			reason: "Sources other than Secret and InjectedIdentity should not be supported.",
+			storage: BackupObjectStorage{
+				Provider:    BackupObjectStorageProviderAWS,
+				Bucket:      "backups",
+				Config:      config(map[string]interface{}{"region": "us-west-2"}),
+				Credentials: BackupCredentials{Source: xpv1.CredentialsSourceEnvironment},
+			},
+			want: []string{"spec.objectStorage.credentials.source: FieldValueNotSupported"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &SharedBackupConfig{Spec: SharedBackupConfigSpec{ObjectStorage: tc.storage}}
+			if diff := cmp.Diff(tc.want, errorFields(c.Validate())); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
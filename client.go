@@ -36,6 +36,8 @@ const (
 	defaultBaseURL     = "https://api.upbound.io"
 	defaultUserAgent   = "up-sdk-go"
 	defaultHTTPTimeout = 10 * time.Second
+
+	errUnauthorizedRefresh = "request was unauthorized and credentials could not be refreshed"
 )
 
 // Client is an HTTP client for communicating with Upbound.
@@ -45,10 +47,21 @@ type Client interface {
 	With(modifiers ...ClientModifierFn) Client
 }
 
+// A StreamingClient is a Client that can stream response bodies, for example
+// to watch resources.
+type StreamingClient interface {
+	Client
+	Stream(req *http.Request) (io.ReadCloser, error)
+}
+
+var _ StreamingClient = &HTTPClient{}
+
 // A ClientModifierFn modifies an HTTP client.
 type ClientModifierFn func(*HTTPClient)
 
-// NewClient builds a new default HTTP client for Upbound.
+// NewClient builds a new default HTTP client for Upbound. Requests are retried
+// according to DefaultRetryPolicy unless WithRetryPolicy supplies another one,
+// or nil to disable retries.
 func NewClient(modifiers ...ClientModifierFn) *HTTPClient {
 	b, _ := url.Parse(defaultBaseURL)
 	c := &HTTPClient{
@@ -58,7 +71,8 @@ func NewClient(modifiers ...ClientModifierFn) *HTTPClient {
 			Timeout:   defaultHTTPTimeout,
 			Transport: NewContextTransport(),
 		},
-		UserAgent: defaultUserAgent,
+		UserAgent:   defaultUserAgent,
+		RetryPolicy: DefaultRetryPolicy(),
 	}
 	for _, m := range modifiers {
 		m(c)
@@ -80,6 +94,10 @@ type HTTPClient struct {
 
 	// User agent for communicating with the Upbound API.
 	UserAgent string
+
+	// RetryPolicy controls how failed requests are retried. Requests are not
+	// retried if it is nil.
+	RetryPolicy *RetryPolicy
 }
 
 // A ResponseErrorHandler handles errors in HTTP responses.
@@ -107,6 +125,7 @@ func (c *HTTPClient) NewRequest(ctx context.Context, method, prefix, urlPath str
 		return nil, err
 	}
 	req.Header.Set("User-Agent", c.UserAgent)
+	req.Header.Set(headers.RequestIDHeader, requestID(ctx))
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
@@ -114,14 +133,15 @@ func (c *HTTPClient) NewRequest(ctx context.Context, method, prefix, urlPath str
 }
 
 // Do performs an HTTP request and reads the body into the provided interface.
+// The request is retried according to the client's RetryPolicy, if any.
 func (c *HTTPClient) Do(req *http.Request, obj interface{}) error {
-	res, err := c.HTTP.Do(req)
+	res, err := c.doWithRetries(c.HTTP, req)
 	if err != nil {
 		return errors.Wrap(err, fmt.Sprintf("failed to perform request with ID: %s", request.IDFromContext(req.Context())))
 	}
 	defer res.Body.Close() // nolint:errcheck
 	if err := c.handleErrors(res); err != nil {
-		return err
+		return withRequest(err, req, res)
 	}
 	if obj != nil {
 		b, err := io.ReadAll(res.Body)
@@ -133,11 +153,45 @@ func (c *HTTPClient) Do(req *http.Request, obj interface{}) error {
 	return nil
 }
 
+// Stream performs an HTTP request and returns the response body without
+// reading it. The caller is responsible for closing the body. Streams are not
+// subject to the HTTP client's timeout, so they should be bounded using the
+// request context.
+func (c *HTTPClient) Stream(req *http.Request) (io.ReadCloser, error) {
+	hc := &http.Client{}
+	*hc = *c.HTTP //nolint:govet // http.Client holds no locks.
+	hc.Timeout = 0
+	res, err := c.doWithRetries(hc, req)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to perform request with ID: %s", request.IDFromContext(req.Context())))
+	}
+	if err := c.handleErrors(res); err != nil {
+		_ = res.Body.Close()
+		return nil, withRequest(err, req, res)
+	}
+	return res.Body, nil
+}
+
 // handleErrors invokes the underlying response error handler.
 func (c *HTTPClient) handleErrors(res *http.Response) error {
 	return c.ErrorHandler.Handle(res)
 }
 
+// withRequest records the request that resulted in an error, so that callers
+// can include it in diagnostics.
+func withRequest(err error, req *http.Request, res *http.Response) error {
+	id := req.Header.Get(headers.RequestIDHeader)
+	if id == "" {
+		id = request.IDFromContext(req.Context())
+	}
+	retryAfter, _ := parseRetryAfter(res.Header.Get(retryAfterHeader), time.Now())
+	return uerrors.WithRequest(err, &uerrors.Request{
+		ID:     id,
+		Method: req.Method,
+		URL:    req.URL.String(),
+	}, retryAfter)
+}
+
 // With returns a new Client after applying given modifiers.
 func (c *HTTPClient) With(modifiers ...ClientModifierFn) Client {
 	nc := &HTTPClient{
@@ -145,6 +199,7 @@ func (c *HTTPClient) With(modifiers ...ClientModifierFn) Client {
 		ErrorHandler: c.ErrorHandler,
 		HTTP:         c.HTTP,
 		UserAgent:    c.UserAgent,
+		RetryPolicy:  c.RetryPolicy,
 	}
 	for _, m := range modifiers {
 		m(nc)
@@ -189,7 +244,8 @@ func (h *DefaultErrorHandler) Handle(res *http.Response) error {
 // ContextTransport is a http.RoundTripper that enables the caller to propagate
 // information within the req.Context to external HTTP targets.
 type ContextTransport struct {
-	transport http.RoundTripper
+	transport   http.RoundTripper
+	credentials Credentials
 }
 
 // ContextTransportOption modifies the underlying ContextTransport.
@@ -203,6 +259,14 @@ func WithTransport(t http.RoundTripper) ContextTransportOption {
 	}
 }
 
+// WithCredentials authenticates every request sent by the ContextTransport
+// using the supplied credentials.
+func WithCredentials(c Credentials) ContextTransportOption {
+	return func(ct *ContextTransport) {
+		ct.credentials = c
+	}
+}
+
 // NewContextTransport constructs a new ContextTransport.
 func NewContextTransport(opts ...ContextTransportOption) *ContextTransport {
 	c := &ContextTransport{
@@ -216,16 +280,66 @@ func NewContextTransport(opts ...ContextTransportOption) *ContextTransport {
 }
 
 // RoundTrip adds information that is deemed important to propagate to the
-// target. Today we only propagate the request-id, but could expand this in
-// the future.
+// target. Today we only propagate the request-id and credentials, but could
+// expand this in the future. If the credentials can be refreshed, they are
+// refreshed and the request is retried once when it is rejected as
+// unauthorized. An error is returned if they cannot be refreshed. The
+// supplied request is not modified.
 func (c *ContextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	// Retrieve x-request-id value from context.
-	id := request.IDFromContext(req.Context())
-	if id == "" {
-		id = request.NewID()
+	// Keep the request-id of requests built by NewRequest, so that retried
+	// requests share it.
+	if req.Header.Get(headers.RequestIDHeader) == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(headers.RequestIDHeader, requestID(req.Context()))
+	}
+
+	if c.credentials == nil {
+		return c.transport.RoundTrip(req)
+	}
+	sent, res, err := c.roundTripWithCredentials(req)
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+	r, ok := c.credentials.(CredentialsRefresher)
+	if !ok || !rewindable(req) {
+		return res, nil
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+	if err := r.Refresh(req.Context(), sent); err != nil {
+		return nil, errors.Wrap(err, errUnauthorizedRefresh)
 	}
-	// Add value to the request.
-	req.Header.Add(headers.RequestIDHeader, id)
+	// Send a clone with a fresh body rather than rewinding the caller's
+	// request.
+	retry := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		b, err := req.GetBody()
+		if err != nil {
+			return nil, errors.Wrap(err, errRewindBody)
+		}
+		retry.Body = b
+	}
+	_, res, err = c.roundTripWithCredentials(retry)
+	return res, err
+}
 
-	return c.transport.RoundTrip(req)
+// requestID returns the request-id of the context, or a new one if there is
+// none.
+func requestID(ctx context.Context) string {
+	if id := request.IDFromContext(ctx); id != "" {
+		return id
+	}
+	return request.NewID()
+}
+
+// roundTripWithCredentials applies credentials to a clone of the request so
+// that the caller's request is not modified, then sends it. It returns the
+// clone that was sent.
+func (c *ContextTransport) roundTripWithCredentials(req *http.Request) (*http.Request, *http.Response, error) {
+	r := req.Clone(req.Context())
+	if err := c.credentials.Apply(r); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot apply credentials")
+	}
+	res, err := c.transport.RoundTrip(r)
+	return r, res, err
 }
@@ -0,0 +1,60 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	uerrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/http/request"
+)
+
+func TestDoErrorRequest(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(retryAfterHeader, "3")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer s.Close()
+	u, _ := url.Parse(s.URL)
+	c := NewClient(func(c *HTTPClient) {
+		c.BaseURL = u
+	}, WithRetryPolicy(nil))
+
+	req, err := c.NewRequest(request.WithID(context.Background(), "cool-id"), http.MethodGet, "v1", "test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.Do(req, nil)
+
+	retryAfter, ok := uerrors.IsRateLimited(err)
+	if !ok {
+		t.Fatalf("IsRateLimited(...): expected rate limited error, got %v", err)
+	}
+	if diff := cmp.Diff(3*time.Second, retryAfter); diff != "" {
+		t.Errorf("\n%s\nIsRateLimited(...): -want, +got:\n%s", "should parse the Retry-After header", diff)
+	}
+	r, _ := uerrors.RequestOf(err)
+	want := &uerrors.Request{ID: "cool-id", Method: http.MethodGet, URL: s.URL + "/v1/test"}
+	if diff := cmp.Diff(want, r); diff != "" {
+		t.Errorf("\n%s\nRequestOf(...): -want, +got:\n%s", "should record the request that resulted in the error", diff)
+	}
+}
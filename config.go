@@ -41,3 +41,12 @@ func NewConfig(modifiers ...ConfigModifierFn) *Config {
 	}
 	return c
 }
+
+// WithConfigCredentials configures the client of the Config to authenticate
+// every request with the supplied credentials. It applies to the client
+// configured by preceding modifiers.
+func WithConfigCredentials(creds Credentials) ConfigModifierFn {
+	return func(c *Config) {
+		c.Client = c.Client.With(WithClientCredentials(creds))
+	}
+}
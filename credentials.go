@@ -0,0 +1,257 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+const (
+	// SessionCookieName is the name of the cookie holding an Upbound user
+	// session.
+	SessionCookieName = "SID"
+
+	// redacted replaces secret material when credentials are printed.
+	redacted = "REDACTED"
+
+	errNoCredentials      = "no credentials"
+	errRefreshCredentials = "cannot refresh credentials"
+)
+
+// Credentials authenticate requests made to Upbound. Implementations must not
+// expose secret material when formatted or logged.
+type Credentials interface {
+	// Apply adds the credentials to the supplied request.
+	Apply(req *http.Request) error
+}
+
+// A CredentialsRefresher is a set of Credentials that can be refreshed when
+// they are rejected as expired.
+type CredentialsRefresher interface {
+	Credentials
+
+	// Refresh refreshes the credentials after the supplied request, to which
+	// they were applied, was rejected. Implementations may skip refreshing if
+	// the credentials changed since the request was sent. The request is nil
+	// if the credentials are refreshed for another reason.
+	Refresh(ctx context.Context, rejected *http.Request) error
+}
+
+var (
+	_ Credentials          = &SessionCredentials{}
+	_ Credentials          = &TokenCredentials{}
+	_ CredentialsRefresher = &RefreshingCredentials{}
+)
+
+// SessionCredentials authenticate requests with a user session cookie.
+type SessionCredentials struct {
+	session string
+}
+
+// NewSessionCredentials builds Credentials from a user session ID.
+func NewSessionCredentials(session string) *SessionCredentials {
+	return &SessionCredentials{session: session}
+}
+
+// Apply sets the session cookie on the request, replacing any existing
+// session cookie.
+func (s *SessionCredentials) Apply(req *http.Request) error {
+	cookies := req.Cookies()
+	req.Header.Del("Cookie")
+	for _, c := range cookies {
+		if c.Name != SessionCookieName {
+			req.AddCookie(c)
+		}
+	}
+	req.AddCookie(&http.Cookie{Name: SessionCookieName, Value: s.session})
+	return nil
+}
+
+// String implements fmt.Stringer without exposing the session.
+func (s *SessionCredentials) String() string {
+	return fmt.Sprintf("Session(%s)", redacted)
+}
+
+// GoString implements fmt.GoStringer without exposing the session.
+func (s *SessionCredentials) GoString() string {
+	return s.String()
+}
+
+// TokenCredentials authenticate requests with a bearer token, such as a
+// personal access token, a robot token or a static org scoped token.
+type TokenCredentials struct {
+	token string
+}
+
+// NewTokenCredentials builds Credentials from a bearer token.
+func NewTokenCredentials(token string) *TokenCredentials {
+	return &TokenCredentials{token: token}
+}
+
+// Apply sets the Authorization header of the request.
+func (t *TokenCredentials) Apply(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	return nil
+}
+
+// String implements fmt.Stringer without exposing the token.
+func (t *TokenCredentials) String() string {
+	return fmt.Sprintf("Token(%s)", redacted)
+}
+
+// GoString implements fmt.GoStringer without exposing the token.
+func (t *TokenCredentials) GoString() string {
+	return t.String()
+}
+
+// A RefreshFn returns fresh Credentials.
+type RefreshFn func(ctx context.Context) (Credentials, error)
+
+// RefreshingCredentials wrap Credentials that are replaced by calling a
+// RefreshFn when they are rejected as expired. Concurrent refreshes result in
+// a single call of the RefreshFn.
+type RefreshingCredentials struct {
+	mu      sync.RWMutex
+	current Credentials
+	refresh RefreshFn
+	group   singleflight.Group
+}
+
+// NewRefreshingCredentials builds Credentials that start with the supplied
+// initial credentials, which may be nil, and are refreshed using fn.
+func NewRefreshingCredentials(initial Credentials, fn RefreshFn) *RefreshingCredentials {
+	return &RefreshingCredentials{current: initial, refresh: fn}
+}
+
+// Apply applies the current credentials to the request. If there are no
+// current credentials they are refreshed first.
+func (r *RefreshingCredentials) Apply(req *http.Request) error {
+	r.mu.RLock()
+	c := r.current
+	r.mu.RUnlock()
+	if c == nil {
+		if err := r.Refresh(req.Context(), nil); err != nil {
+			return err
+		}
+		r.mu.RLock()
+		c = r.current
+		r.mu.RUnlock()
+	}
+	return c.Apply(req)
+}
+
+// Refresh replaces the current credentials with those returned by the
+// RefreshFn. Callers that refresh while a refresh is in progress wait for it
+// rather than starting another. The refresh is skipped if the rejected
+// request was sent with credentials other than the current ones, i.e. they
+// were refreshed since.
+func (r *RefreshingCredentials) Refresh(ctx context.Context, rejected *http.Request) error {
+	r.mu.RLock()
+	c := r.current
+	r.mu.RUnlock()
+	if rejected != nil && c != nil && !appliedTo(c, rejected) {
+		return nil
+	}
+	ch := r.group.DoChan("", func() (interface{}, error) {
+		// Don't let callers that give up on waiting cancel the refresh
+		// other callers are waiting for.
+		c, err := r.refresh(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, errors.Wrap(err, errRefreshCredentials)
+		}
+		if c == nil {
+			return nil, errors.New(errNoCredentials)
+		}
+		r.mu.Lock()
+		r.current = c
+		r.mu.Unlock()
+		return nil, nil
+	})
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case res := <-ch:
+		return res.Err
+	}
+}
+
+// appliedTo returns true if the credentials were applied to the request, i.e.
+// the request has the headers and cookies the credentials set.
+func appliedTo(c Credentials, req *http.Request) bool {
+	probe := &http.Request{Header: http.Header{}}
+	if err := c.Apply(probe); err != nil {
+		return false
+	}
+	for k, v := range probe.Header {
+		if k == "Cookie" {
+			continue
+		}
+		if !slices.Equal(v, req.Header.Values(k)) {
+			return false
+		}
+	}
+	for _, want := range probe.Cookies() {
+		got, err := req.Cookie(want.Name)
+		if err != nil || got.Value != want.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements fmt.Stringer without exposing the current credentials.
+func (r *RefreshingCredentials) String() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if s, ok := r.current.(fmt.Stringer); ok {
+		return fmt.Sprintf("Refreshing(%s)", s)
+	}
+	return fmt.Sprintf("Refreshing(%s)", redacted)
+}
+
+// GoString implements fmt.GoStringer without exposing the current
+// credentials.
+func (r *RefreshingCredentials) GoString() string {
+	return r.String()
+}
+
+// WithClientCredentials configures the client to authenticate every request
+// with the supplied credentials. The client's underlying http.Client is copied
+// rather than modified, so it may be shared with other clients.
+func WithClientCredentials(creds Credentials) ClientModifierFn {
+	return func(c *HTTPClient) {
+		hc := &http.Client{}
+		if c.HTTP != nil {
+			*hc = *c.HTTP //nolint:govet // http.Client holds no locks.
+		}
+		t := hc.Transport
+		if ct, ok := t.(*ContextTransport); ok {
+			t = ct.transport
+		}
+		if t == nil {
+			t = http.DefaultTransport
+		}
+		hc.Transport = NewContextTransport(WithTransport(t), WithCredentials(creds))
+		c.HTTP = hc
+	}
+}
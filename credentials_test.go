@@ -0,0 +1,362 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up-sdk-go/http/headers"
+	"github.com/upbound/up-sdk-go/http/request"
+)
+
+func TestCredentialsApply(t *testing.T) {
+	type want struct {
+		authorization string
+		cookie        string
+	}
+	cases := map[string]struct {
+		reason string
+		creds  Credentials
+		header http.Header
+		want   want
+	}{
+		"Session": {
+			reason: "Session credentials should set the session cookie.",
+			creds:  NewSessionCredentials("sid"),
+			want:   want{cookie: "SID=sid"},
+		},
+		"SessionReplacesExisting": {
+			reason: "Session credentials should replace an existing session cookie and keep other cookies.",
+			creds:  NewSessionCredentials("sid"),
+			header: http.Header{"Cookie": []string{"SID=old; other=cool"}},
+			want:   want{cookie: "other=cool; SID=sid"},
+		},
+		"Token": {
+			reason: "Token credentials should set the Authorization header.",
+			creds:  NewTokenCredentials("token"),
+			want:   want{authorization: "Bearer token"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://localhost:8080", nil)
+			for k, v := range tc.header {
+				req.Header[k] = v
+			}
+			if err := tc.creds.Apply(req); err != nil {
+				t.Fatal(err)
+			}
+			got := want{authorization: req.Header.Get("Authorization"), cookie: req.Header.Get("Cookie")}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCredentialsRedacted(t *testing.T) {
+	secret := "super-secret"
+	creds := []Credentials{
+		NewSessionCredentials(secret),
+		NewTokenCredentials(secret),
+		NewRefreshingCredentials(NewTokenCredentials(secret), nil),
+	}
+	for _, c := range creds {
+		for _, verb := range []string{"%s", "%v", "%+v", "%#v"} {
+			if s := fmt.Sprintf(verb, c); strings.Contains(s, secret) {
+				t.Errorf("fmt.Sprintf(%q, %T): exposes secret: %s", verb, c, s)
+			}
+		}
+	}
+}
+
+func TestContextTransportCredentials(t *testing.T) {
+	type want struct {
+		status    int
+		attempts  int
+		refreshes int
+		bodies    []string
+		err       bool
+	}
+	cases := map[string]struct {
+		reason string
+		valid  string
+		creds  func(refreshes *int) Credentials
+		body   interface{}
+		want   want
+	}{
+		"Authorized": {
+			reason: "Requests with valid credentials should succeed.",
+			valid:  "Bearer good",
+			creds: func(_ *int) Credentials {
+				return NewTokenCredentials("good")
+			},
+			want: want{status: http.StatusOK, attempts: 1},
+		},
+		"UnauthorizedNotRefreshable": {
+			reason: "Unauthorized requests should not be retried if credentials cannot be refreshed.",
+			valid:  "Bearer good",
+			creds: func(_ *int) Credentials {
+				return NewTokenCredentials("bad")
+			},
+			want: want{status: http.StatusUnauthorized, attempts: 1},
+		},
+		"Refreshed": {
+			reason: "Unauthorized requests should be retried once with refreshed credentials.",
+			valid:  "Bearer good",
+			creds: func(refreshes *int) Credentials {
+				return NewRefreshingCredentials(NewTokenCredentials("expired"), func(_ context.Context) (Credentials, error) {
+					*refreshes++
+					return NewTokenCredentials("good"), nil
+				})
+			},
+			body: map[string]string{"name": "cool"},
+			want: want{
+				status:    http.StatusOK,
+				attempts:  2,
+				refreshes: 1,
+				bodies:    []string{"{\"name\":\"cool\"}\n", "{\"name\":\"cool\"}\n"},
+			},
+		},
+		"RefreshFailed": {
+			reason: "An error should be returned if refreshing fails.",
+			valid:  "Bearer good",
+			creds: func(refreshes *int) Credentials {
+				return NewRefreshingCredentials(NewTokenCredentials("expired"), func(_ context.Context) (Credentials, error) {
+					*refreshes++
+					return nil, errors.New("boom")
+				})
+			},
+			want: want{attempts: 1, refreshes: 1, err: true},
+		},
+		"StillUnauthorized": {
+			reason: "Refreshed credentials should only be tried once.",
+			valid:  "Bearer good",
+			creds: func(refreshes *int) Credentials {
+				return NewRefreshingCredentials(nil, func(_ context.Context) (Credentials, error) {
+					*refreshes++
+					return NewTokenCredentials("bad"), nil
+				})
+			},
+			want: want{status: http.StatusUnauthorized, attempts: 2, refreshes: 2},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got.attempts++
+				if b, _ := io.ReadAll(r.Body); len(b) > 0 {
+					got.bodies = append(got.bodies, string(b))
+				}
+				if r.Header.Get("Authorization") != tc.valid {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer s.Close()
+			u, _ := url.Parse(s.URL)
+			c := NewClient(func(c *HTTPClient) {
+				c.BaseURL = u
+				c.HTTP = s.Client()
+			}, WithClientCredentials(tc.creds(&got.refreshes)))
+
+			req, err := c.NewRequest(context.Background(), http.MethodPost, "v1", "test", tc.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, header := req.Body, req.Header.Clone()
+			res, err := c.HTTP.Do(req)
+			got.err = err != nil
+			if err == nil {
+				defer res.Body.Close() //nolint:errcheck // Not relevant in tests.
+				got.status = res.StatusCode
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if !cmp.Equal(header, req.Header) || req.Body != body {
+				t.Errorf("\n%s\nRoundTrip(...): modified caller's request", tc.reason)
+			}
+		})
+	}
+}
+
+func TestWithConfigCredentials(t *testing.T) {
+	var got string
+	s := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+	}))
+	defer s.Close()
+	u, _ := url.Parse(s.URL)
+	cfg := NewConfig(func(cfg *Config) {
+		cfg.Client = NewClient(func(c *HTTPClient) {
+			c.BaseURL = u
+			c.HTTP = s.Client()
+		})
+	}, WithConfigCredentials(NewTokenCredentials("token")))
+
+	req, err := cfg.Client.NewRequest(context.Background(), http.MethodGet, "v1", "test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Client.Do(req, nil); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("Bearer token", got); diff != "" {
+		t.Errorf("\n%s\nDo(...): -want, +got:\n%s", "Requests of the config's client should be authenticated.", diff)
+	}
+}
+
+func TestContextTransportRequestID(t *testing.T) {
+	var got string
+	s := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get(headers.RequestIDHeader)
+	}))
+	defer s.Close()
+
+	req, _ := http.NewRequestWithContext(request.WithID(context.Background(), "cool-id"), http.MethodGet, s.URL, nil)
+	res, err := (&http.Client{Transport: NewContextTransport()}).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	if diff := cmp.Diff("cool-id", got); diff != "" {
+		t.Errorf("\n%s\nRoundTrip(...): -want, +got:\n%s", "The request-id of the context should be sent.", diff)
+	}
+	if h := req.Header.Get(headers.RequestIDHeader); h != "" {
+		t.Errorf("\n%s\nRoundTrip(...): caller's request has request-id %q", "The caller's request should not be modified.", h)
+	}
+}
+
+func TestRefreshingCredentialsRefresh(t *testing.T) {
+	applied := func(c Credentials) *http.Request {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://localhost:8080", nil)
+		_ = c.Apply(req)
+		return req
+	}
+	cases := map[string]struct {
+		reason    string
+		rejected  *http.Request
+		refreshes int
+	}{
+		"Current": {
+			reason:    "Credentials should be refreshed if the current ones were rejected.",
+			rejected:  applied(NewTokenCredentials("expired")),
+			refreshes: 1,
+		},
+		"AlreadyRefreshed": {
+			reason:    "Credentials should not be refreshed if they were refreshed since the rejected request was sent.",
+			rejected:  applied(NewTokenCredentials("older")),
+			refreshes: 0,
+		},
+		"NoRequest": {
+			reason:    "Credentials should be refreshed if no rejected request is supplied.",
+			refreshes: 1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			refreshes := 0
+			c := NewRefreshingCredentials(NewTokenCredentials("expired"), func(_ context.Context) (Credentials, error) {
+				refreshes++
+				return NewTokenCredentials("good"), nil
+			})
+			if err := c.Refresh(context.Background(), tc.rejected); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.refreshes, refreshes); diff != "" {
+				t.Errorf("\n%s\nRefresh(...): -want refreshes, +got refreshes:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRefreshingCredentialsCanceledCaller(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	var refreshes atomic.Int32
+	c := NewRefreshingCredentials(NewTokenCredentials("expired"), func(ctx context.Context) (Credentials, error) {
+		if refreshes.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		return NewTokenCredentials("good"), ctx.Err()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() { first <- c.Refresh(ctx, nil) }()
+	<-started
+	second := make(chan error, 1)
+	go func() { second <- c.Refresh(context.Background(), nil) }()
+	// Give the second caller a chance to join the refresh in progress.
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Errorf("\n%s\nRefresh(...): want %v, got %v", "A caller that gives up should return its context's error.", context.Canceled, err)
+	}
+	close(release)
+	if err := <-second; err != nil {
+		t.Errorf("\n%s\nRefresh(...): %v", "Other callers should not fail because the first caller gave up.", err)
+	}
+	if got := refreshes.Load(); got != 1 {
+		t.Errorf("\n%s\nRefresh(...): want 1 refresh, got %d", "The second caller should have waited for the refresh in progress.", got)
+	}
+}
+
+func TestRefreshingCredentialsConcurrentRefresh(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	var refreshes atomic.Int32
+	c := NewRefreshingCredentials(NewTokenCredentials("expired"), func(_ context.Context) (Credentials, error) {
+		if refreshes.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		return NewTokenCredentials("good"), nil
+	})
+
+	const callers = 10
+	errs := make(chan error, callers)
+	go func() { errs <- c.Refresh(context.Background(), nil) }()
+	<-started
+	for range callers - 1 {
+		go func() { errs <- c.Refresh(context.Background(), nil) }()
+	}
+	// Give the other callers a chance to join the refresh in progress.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	for range callers {
+		if err := <-errs; err != nil {
+			t.Errorf("Refresh(...): %v", err)
+		}
+	}
+	if got := refreshes.Load(); got != 1 {
+		t.Errorf("\n%s\nRefresh(...): want 1 refresh, got %d", "Concurrent refreshes should share a single call of the RefreshFn.", got)
+	}
+}
@@ -12,28 +12,52 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+// Package errors contains the errors returned by Upbound SDK clients and
+// helpers to inspect them. The helpers work for both errors returned by
+// Upbound REST APIs and Kubernetes style errors returned by Spaces APIs.
 package errors
 
 import (
+	stderrors "errors"
 	"fmt"
+	"math"
 	"net/http"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-var _ error = &Error{}
+var (
+	_ error               = &Error{}
+	_ error               = &RequestError{}
+	_ apierrors.APIStatus = &StatusError{}
+)
 
 // Error is an Upbound SDK error response.
 type Error struct {
 	Status int     `json:"status"`
 	Title  string  `json:"title"`
 	Detail *string `json:"detail,omitempty"`
+
+	// Request is the request that resulted in the error, if known.
+	Request *Request `json:"-"`
+
+	// RetryAfter is how long the server asked the client to wait before
+	// retrying the request, if it did.
+	RetryAfter time.Duration `json:"-"`
 }
 
-// Error returns the error message with the underlying error.
+// Error returns the error message with the underlying error, and the request
+// that resulted in it if known.
 func (e *Error) Error() string {
 	detail := ""
 	if e.Detail != nil {
 		detail = fmt.Sprintf(": %s", *e.Detail)
 	}
+	if e.Request != nil {
+		return fmt.Sprintf("%s%s (%s)", e.Title, detail, e.Request)
+	}
 	return fmt.Sprintf("%s%s", e.Title, detail)
 }
 
@@ -43,14 +67,241 @@ func (e *Error) IsNotFound() bool {
 	return e.Status == http.StatusNotFound
 }
 
-// IsNotFound returns true if the error is an Upbound SDK NotFound error, and
-// false otherwise.
+// Request describes the HTTP request that resulted in an error.
+type Request struct {
+	// ID is the request ID sent with the request.
+	ID string
+
+	// Method is the HTTP method of the request.
+	Method string
+
+	// URL is the URL of the request.
+	URL string
+}
+
+// String returns the method and URL of the request, and its ID if known.
+func (r *Request) String() string {
+	if r.ID == "" {
+		return fmt.Sprintf("%s %s", r.Method, r.URL)
+	}
+	return fmt.Sprintf("%s %s, request ID %s", r.Method, r.URL, r.ID)
+}
+
+// RequestError wraps an error that is neither an Upbound SDK error response
+// nor a Kubernetes status error with the request that resulted in it.
+type RequestError struct {
+	// Err is the wrapped error.
+	Err error
+
+	// Request is the request that resulted in the error.
+	Request *Request
+
+	// RetryAfter is how long the server asked the client to wait before
+	// retrying the request, if it did.
+	RetryAfter time.Duration
+}
+
+// Error returns the message of the wrapped error, and the request that
+// resulted in it if known.
+func (e *RequestError) Error() string {
+	if e.Request == nil {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s (%s)", e.Err, e.Request)
+}
+
+// Unwrap returns the wrapped error.
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// StatusError wraps a Kubernetes status error with the request that resulted
+// in it. It is a Kubernetes status error itself, so the helpers of
+// k8s.io/apimachinery/pkg/api/errors work with it, and the wrapped error can
+// be retrieved using errors.As.
+type StatusError struct {
+	// Err is the wrapped error. It is or wraps a Kubernetes status error.
+	Err error
+
+	// Request is the request that resulted in the error.
+	Request *Request
+
+	// RetryAfter is how long the server asked the client to wait before
+	// retrying the request, if it did.
+	RetryAfter time.Duration
+}
+
+// Error returns the message of the wrapped error, and the request that
+// resulted in it if known.
+func (e *StatusError) Error() string {
+	if e.Request == nil {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s (%s)", e.Err, e.Request)
+}
+
+// Status returns the status of the wrapped error. If the server asked the
+// client to wait before retrying the request, the returned status suggests
+// the delay unless it already does.
+func (e *StatusError) Status() metav1.Status {
+	var st apierrors.APIStatus
+	if !stderrors.As(e.Err, &st) {
+		return metav1.Status{Status: metav1.StatusFailure, Message: e.Err.Error()}
+	}
+	s := st.Status()
+	if e.RetryAfter <= 0 || (s.Details != nil && s.Details.RetryAfterSeconds > 0) {
+		return s
+	}
+	d := &metav1.StatusDetails{}
+	if s.Details != nil {
+		d = s.Details.DeepCopy()
+	}
+	d.RetryAfterSeconds = int32(math.Ceil(e.RetryAfter.Seconds()))
+	s.Details = d
+	return s
+}
+
+// Unwrap returns the wrapped error.
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// WithRequest records the request that resulted in an error, and how long the
+// server asked the client to wait before retrying it. Upbound SDK error
+// responses are updated in place. Kubernetes status errors are wrapped in a
+// StatusError, and other errors in a RequestError.
+func WithRequest(err error, req *Request, retryAfter time.Duration) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if stderrors.As(err, &e) {
+		e.Request = req
+		e.RetryAfter = retryAfter
+		return err
+	}
+	var s apierrors.APIStatus
+	if stderrors.As(err, &s) {
+		return &StatusError{Err: err, Request: req, RetryAfter: retryAfter}
+	}
+	return &RequestError{Err: err, Request: req, RetryAfter: retryAfter}
+}
+
+// RequestOf returns the request that resulted in an error, if known.
+func RequestOf(err error) (*Request, bool) {
+	var e *Error
+	if stderrors.As(err, &e) && e.Request != nil {
+		return e.Request, true
+	}
+	var se *StatusError
+	if stderrors.As(err, &se) && se.Request != nil {
+		return se.Request, true
+	}
+	var re *RequestError
+	if stderrors.As(err, &re) && re.Request != nil {
+		return re.Request, true
+	}
+	return nil, false
+}
+
+// statusCode returns the HTTP status code of an Upbound SDK error response or
+// a Kubernetes status error.
+func statusCode(err error) (int, bool) {
+	var e *Error
+	if stderrors.As(err, &e) {
+		return e.Status, true
+	}
+	var s apierrors.APIStatus
+	if stderrors.As(err, &s) {
+		return int(s.Status().Code), true
+	}
+	return 0, false
+}
+
+// isUpboundStatus returns true if the error is an Upbound SDK error response
+// with one of the supplied status codes.
+func isUpboundStatus(err error, codes ...int) bool {
+	var e *Error
+	if !stderrors.As(err, &e) {
+		return false
+	}
+	for _, c := range codes {
+		if e.Status == c {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound returns true if the error is an Upbound SDK NotFound error or a
+// Kubernetes NotFound status error, and false otherwise.
 func IsNotFound(err error) bool {
-	e, ok := err.(interface { // nolint:errorlint
+	var e interface {
 		IsNotFound() bool
-	})
-	if !ok {
-		return false
 	}
-	return e.IsNotFound()
+	if stderrors.As(err, &e) {
+		return e.IsNotFound()
+	}
+	return apierrors.IsNotFound(err)
+}
+
+// IsConflict returns true if the error is an Upbound SDK Conflict error or a
+// Kubernetes Conflict status error, and false otherwise.
+func IsConflict(err error) bool {
+	return isUpboundStatus(err, http.StatusConflict) || apierrors.IsConflict(err)
+}
+
+// IsAlreadyExists returns true if the error is a Kubernetes AlreadyExists
+// status error, and false otherwise. Upbound REST APIs do not distinguish
+// between conflicts and existing resources, so any Upbound SDK Conflict error
+// is also considered to be an AlreadyExists error.
+func IsAlreadyExists(err error) bool {
+	return isUpboundStatus(err, http.StatusConflict) || apierrors.IsAlreadyExists(err)
+}
+
+// IsUnauthorized returns true if the error is an Upbound SDK Unauthorized
+// error or a Kubernetes Unauthorized status error, and false otherwise.
+func IsUnauthorized(err error) bool {
+	return isUpboundStatus(err, http.StatusUnauthorized) || apierrors.IsUnauthorized(err)
+}
+
+// IsForbidden returns true if the error is an Upbound SDK Forbidden error or a
+// Kubernetes Forbidden status error, and false otherwise.
+func IsForbidden(err error) bool {
+	return isUpboundStatus(err, http.StatusForbidden) || apierrors.IsForbidden(err)
+}
+
+// IsInvalid returns true if the error is an Upbound SDK BadRequest or
+// UnprocessableEntity error, or a Kubernetes Invalid status error, and false
+// otherwise.
+func IsInvalid(err error) bool {
+	return isUpboundStatus(err, http.StatusBadRequest, http.StatusUnprocessableEntity) || apierrors.IsInvalid(err)
+}
+
+// IsRateLimited returns true if the error is an Upbound SDK TooManyRequests
+// error or a Kubernetes TooManyRequests status error, and false otherwise. It
+// also returns how long the server asked the client to wait before retrying,
+// which is zero if it did not.
+func IsRateLimited(err error) (time.Duration, bool) {
+	if c, ok := statusCode(err); !ok || c != http.StatusTooManyRequests {
+		if !apierrors.IsTooManyRequests(err) {
+			return 0, false
+		}
+	}
+	var e *Error
+	if stderrors.As(err, &e) {
+		return e.RetryAfter, true
+	}
+	var se *StatusError
+	if stderrors.As(err, &se) && se.RetryAfter > 0 {
+		return se.RetryAfter, true
+	}
+	var re *RequestError
+	if stderrors.As(err, &re) && re.RetryAfter > 0 {
+		return re.RetryAfter, true
+	}
+	if s, ok := apierrors.SuggestsClientDelay(err); ok {
+		return time.Duration(s) * time.Second, true
+	}
+	return 0, true
 }
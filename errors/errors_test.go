@@ -17,9 +17,14 @@ package errors
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestIsNotFound(t *testing.T) {
@@ -45,6 +50,16 @@ func TestIsNotFound(t *testing.T) {
 			err:    errors.New("other error"),
 			want:   false,
 		},
+		"TestWrappedIsNotFound": {
+			reason: "Wrapped error with type ErrorTypeNotFound should return true.",
+			err:    errors.Wrap(&Error{Status: http.StatusNotFound}, "boom"),
+			want:   true,
+		},
+		"TestKubeIsNotFound": {
+			reason: "Kubernetes NotFound status error should return true.",
+			err:    apierrors.NewNotFound(schema.GroupResource{Resource: "spaces"}, "cool"),
+			want:   true,
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -54,3 +69,230 @@ func TestIsNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestStatusHelpers(t *testing.T) {
+	gr := schema.GroupResource{Group: "spaces.upbound.io", Resource: "controlplanes"}
+	helpers := map[string]func(error) bool{
+		"IsConflict":      IsConflict,
+		"IsAlreadyExists": IsAlreadyExists,
+		"IsUnauthorized":  IsUnauthorized,
+		"IsForbidden":     IsForbidden,
+		"IsInvalid":       IsInvalid,
+		"IsRateLimited": func(err error) bool {
+			_, ok := IsRateLimited(err)
+			return ok
+		},
+	}
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   []string
+	}{
+		"UpboundConflict": {
+			reason: "An Upbound conflict is both a conflict and an existing resource.",
+			err:    &Error{Status: http.StatusConflict},
+			want:   []string{"IsAlreadyExists", "IsConflict"},
+		},
+		"UpboundUnauthorized": {
+			reason: "An Upbound unauthorized error should be detected.",
+			err:    &Error{Status: http.StatusUnauthorized},
+			want:   []string{"IsUnauthorized"},
+		},
+		"UpboundForbidden": {
+			reason: "A wrapped Upbound forbidden error should be detected.",
+			err:    errors.Wrap(&Error{Status: http.StatusForbidden}, "boom"),
+			want:   []string{"IsForbidden"},
+		},
+		"UpboundBadRequest": {
+			reason: "An Upbound bad request error is invalid.",
+			err:    &Error{Status: http.StatusBadRequest},
+			want:   []string{"IsInvalid"},
+		},
+		"UpboundTooManyRequests": {
+			reason: "An Upbound too many requests error is rate limited.",
+			err:    &Error{Status: http.StatusTooManyRequests},
+			want:   []string{"IsRateLimited"},
+		},
+		"KubeConflict": {
+			reason: "A Kubernetes conflict is not an existing resource.",
+			err:    apierrors.NewConflict(gr, "cool", errors.New("boom")),
+			want:   []string{"IsConflict"},
+		},
+		"KubeAlreadyExists": {
+			reason: "A Kubernetes existing resource is not a conflict.",
+			err:    apierrors.NewAlreadyExists(gr, "cool"),
+			want:   []string{"IsAlreadyExists"},
+		},
+		"KubeUnauthorized": {
+			reason: "A Kubernetes unauthorized error should be detected.",
+			err:    apierrors.NewUnauthorized("boom"),
+			want:   []string{"IsUnauthorized"},
+		},
+		"KubeForbidden": {
+			reason: "A Kubernetes forbidden error should be detected.",
+			err:    apierrors.NewForbidden(gr, "cool", errors.New("boom")),
+			want:   []string{"IsForbidden"},
+		},
+		"KubeInvalid": {
+			reason: "A Kubernetes invalid error should be detected.",
+			err:    apierrors.NewInvalid(schema.GroupKind{Kind: "ControlPlane"}, "cool", nil),
+			want:   []string{"IsInvalid"},
+		},
+		"KubeTooManyRequests": {
+			reason: "A Kubernetes too many requests error is rate limited.",
+			err:    apierrors.NewTooManyRequests("boom", 5),
+			want:   []string{"IsRateLimited"},
+		},
+		"Other": {
+			reason: "Other errors should not be detected.",
+			err:    errors.New("boom"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for _, h := range []string{"IsAlreadyExists", "IsConflict", "IsForbidden", "IsInvalid", "IsRateLimited", "IsUnauthorized"} {
+				if helpers[h](tc.err) {
+					got = append(got, h)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIs...(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsRateLimitedRetryAfter(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   time.Duration
+	}{
+		"UpboundRetryAfter": {
+			reason: "The Retry-After of an Upbound error should be returned.",
+			err:    &Error{Status: http.StatusTooManyRequests, RetryAfter: 3 * time.Second},
+			want:   3 * time.Second,
+		},
+		"KubeRetryAfter": {
+			reason: "The Retry-After recorded for a Kubernetes error should be returned.",
+			err:    WithRequest(apierrors.NewTooManyRequests("boom", 5), &Request{}, 7*time.Second),
+			want:   7 * time.Second,
+		},
+		"KubeRetryAfterSeconds": {
+			reason: "The suggested delay of a Kubernetes error should be returned if there is no Retry-After.",
+			err:    apierrors.NewTooManyRequests("boom", 5),
+			want:   5 * time.Second,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := IsRateLimited(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsRateLimited(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWithRequest(t *testing.T) {
+	req := &Request{ID: "id", Method: http.MethodGet, URL: "https://api.upbound.io/v1/cool"}
+	boom := errors.New("boom")
+	kube := &apierrors.StatusError{ErrStatus: metav1.Status{Code: http.StatusNotFound, Reason: metav1.StatusReasonNotFound}}
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   error
+	}{
+		"Nil": {
+			reason: "A nil error should remain nil.",
+		},
+		"Upbound": {
+			reason: "An Upbound error should be updated in place.",
+			err:    &Error{Status: http.StatusNotFound},
+			want:   &Error{Status: http.StatusNotFound, Request: req, RetryAfter: time.Second},
+		},
+		"Kube": {
+			reason: "A Kubernetes status error should be wrapped in a status error.",
+			err:    kube,
+			want:   &StatusError{Err: kube, Request: req, RetryAfter: time.Second},
+		},
+		"Other": {
+			reason: "Other errors should be wrapped.",
+			err:    boom,
+			want:   &RequestError{Err: boom, Request: req, RetryAfter: time.Second},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := WithRequest(tc.err, req, time.Second)
+			// Compare wrapped errors by identity, but the returned ones
+			// structurally.
+			nested := cmp.FilterPath(func(p cmp.Path) bool { return len(p) > 1 }, cmpopts.EquateErrors())
+			if diff := cmp.Diff(tc.want, got, nested); diff != "" {
+				t.Errorf("\n%s\nWithRequest(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if r, ok := RequestOf(got); ok && !cmp.Equal(r, req) {
+				t.Errorf("\n%s\nRequestOf(...): got unexpected request %v", tc.reason, r)
+			}
+		})
+	}
+}
+
+func TestStatusError(t *testing.T) {
+	kube := apierrors.NewNotFound(schema.GroupResource{Group: "spaces.upbound.io", Resource: "controlplanes"}, "cool")
+	err := WithRequest(kube, &Request{Method: http.MethodGet, URL: "https://api.upbound.io/v1/cool"}, time.Second)
+
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("\n%s\nIsNotFound(...): want true, got false", "The helpers of the apierrors package should work with wrapped status errors.")
+	}
+	var se *apierrors.StatusError
+	if !errors.As(err, &se) || se != kube {
+		t.Errorf("\n%s\nAs(...): want %v, got %v", "The wrapped status error should be retrievable.", kube, se)
+	}
+	if d := kube.ErrStatus.Details; d.RetryAfterSeconds != 0 || len(d.Causes) != 0 {
+		t.Errorf("\n%s\nWithRequest(...): modified status details: %v", "The status returned by the server should not be modified.", d)
+	}
+	var s apierrors.APIStatus
+	if !errors.As(err, &s) || s.Status().Details.RetryAfterSeconds != 1 {
+		t.Errorf("\n%s\nStatus(): want a suggested delay of 1s", "The status should suggest the Retry-After as a client delay.")
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	detail := "no such space"
+	req := &Request{ID: "id", Method: http.MethodGet, URL: "https://api.upbound.io/v1/cool"}
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   string
+	}{
+		"Upbound": {
+			reason: "An Upbound error without a request should only include its title and detail.",
+			err:    &Error{Title: "Not Found", Detail: &detail},
+			want:   "Not Found: no such space",
+		},
+		"UpboundWithRequest": {
+			reason: "An Upbound error should include the request that resulted in it.",
+			err:    &Error{Title: "Not Found", Detail: &detail, Request: req},
+			want:   "Not Found: no such space (GET https://api.upbound.io/v1/cool, request ID id)",
+		},
+		"RequestError": {
+			reason: "A wrapped error should include the request that resulted in it.",
+			err:    &RequestError{Err: errors.New("boom"), Request: &Request{Method: http.MethodGet, URL: "https://api.upbound.io/v1/cool"}},
+			want:   "boom (GET https://api.upbound.io/v1/cool)",
+		},
+		"StatusError": {
+			reason: "A wrapped status error should include the request that resulted in it.",
+			err:    &StatusError{Err: apierrors.NewBadRequest("boom"), Request: req},
+			want:   "boom (GET https://api.upbound.io/v1/cool, request ID id)",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.err.Error()); diff != "" {
+				t.Errorf("\n%s\nError(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+
+	// retryAfterHeader is the header used by the server to indicate how long
+	// the client should wait before making a follow-up request.
+	retryAfterHeader = "Retry-After"
+
+	errRewindBody = "cannot rewind request body for retry"
+)
+
+// defaultRetryableStatusCodes are the response status codes that are retried
+// when a RetryPolicy does not specify its own.
+var defaultRetryableStatusCodes = []int{ //nolint:gochecknoglobals // Read-only defaults.
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// A RetryPolicy controls how an HTTPClient retries failed requests. Only
+// idempotent requests are retried, and only when their body can be rewound.
+// Requests built with NewRequest always satisfy the latter.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the initial attempt.
+	MaxRetries int
+
+	// MinBackoff is the backoff before the first retry. Subsequent backoffs
+	// double until MaxBackoff is reached.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the computed backoff and any Retry-After returned by
+	// the server.
+	MaxBackoff time.Duration
+
+	// StatusCodes are the response status codes that are retried. Temporary
+	// network errors are always retried.
+	StatusCodes []int
+}
+
+// DefaultRetryPolicy returns a RetryPolicy that retries idempotent requests
+// up to three times on 429, 502, 503 and 504 responses and temporary network
+// errors. Clients built by NewClient use it unless configured otherwise.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:  defaultMaxRetries,
+		MinBackoff:  defaultMinBackoff,
+		MaxBackoff:  defaultMaxBackoff,
+		StatusCodes: defaultRetryableStatusCodes,
+	}
+}
+
+// WithRetryPolicy configures the client to retry requests according to the
+// supplied policy. A nil policy disables retries.
+func WithRetryPolicy(p *RetryPolicy) ClientModifierFn {
+	return func(c *HTTPClient) {
+		c.RetryPolicy = p
+	}
+}
+
+// isIdempotent returns true if the request method is idempotent as defined by
+// RFC 9110.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// canRetry returns true if the request may be sent more than once.
+func (p *RetryPolicy) canRetry(req *http.Request) bool {
+	if p == nil || p.MaxRetries <= 0 || !isIdempotent(req) {
+		return false
+	}
+	return rewindable(req)
+}
+
+// rewindable returns true if the request body can be sent more than once.
+func rewindable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// shouldRetry returns true if the outcome of an attempt is retryable. Errors
+// are only retried if they are temporary and the request's context is not
+// done.
+func (p *RetryPolicy) shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if err != nil {
+		return req.Context().Err() == nil && temporary(err)
+	}
+	codes := p.StatusCodes
+	if len(codes) == 0 {
+		codes = defaultRetryableStatusCodes
+	}
+	return slices.Contains(codes, res.StatusCode)
+}
+
+// temporary returns true if the error is a network error that may not occur
+// again, such as a timeout or a reset connection.
+func temporary(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// backoff returns how long to wait before the retry following the supplied
+// attempt. A Retry-After returned by the server takes precedence over the
+// computed, jittered exponential backoff. Both are capped by MaxBackoff.
+func (p *RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	minB, maxB := p.MinBackoff, p.MaxBackoff
+	if minB <= 0 {
+		minB = defaultMinBackoff
+	}
+	if maxB < minB {
+		maxB = minB
+	}
+	if res != nil {
+		if d, ok := parseRetryAfter(res.Header.Get(retryAfterHeader), time.Now()); ok {
+			return min(d, maxB)
+		}
+	}
+	d := minB
+	for i := 0; i < attempt && d < maxB; i++ {
+		d *= 2
+	}
+	d = min(d, maxB)
+	// Equal jitter: wait at least half of the backoff so that retries
+	// still back off while spreading out concurrent clients.
+	half := d / 2
+	return half + rand.N(d-half+1) //nolint:gosec // Jitter does not need a secure source.
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil {
+		if s < 0 {
+			return 0, false
+		}
+		return time.Duration(s) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	return max(t.Sub(now), 0), true
+}
+
+// doWithRetries performs the request using the supplied HTTP client, retrying
+// it according to the client's RetryPolicy. The response of the final attempt
+// is returned unconsumed.
+func (c *HTTPClient) doWithRetries(hc *http.Client, req *http.Request) (*http.Response, error) {
+	p := c.RetryPolicy
+	if !p.canRetry(req) {
+		return hc.Do(req)
+	}
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		res, err := hc.Do(req)
+		if attempt >= p.MaxRetries || !p.shouldRetry(req, res, err) {
+			return res, err
+		}
+		wait := p.backoff(attempt, res)
+		// Give up early rather than sleeping past the context deadline.
+		if d, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(d) {
+			return res, err
+		}
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+		if err := rewindBody(req); err != nil {
+			return nil, errors.Wrap(err, errRewindBody)
+		}
+	}
+}
+
+// rewindBody resets the request body so that the request can be sent again.
+func rewindBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+	b, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = b
+	return nil
+}
+
+// sleep waits for the supplied duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
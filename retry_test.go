@@ -0,0 +1,322 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	uerrors "github.com/upbound/up-sdk-go/errors"
+)
+
+func TestDoRetries(t *testing.T) {
+	type want struct {
+		attempts int32
+		status   int
+		bodies   []string
+	}
+	cases := map[string]struct {
+		reason   string
+		policy   *RetryPolicy
+		method   string
+		body     interface{}
+		statuses []int
+		header   http.Header
+		want     want
+	}{
+		"NoPolicy": {
+			reason:   "A client whose retry policy was disabled should only make a single attempt.",
+			method:   http.MethodGet,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			want:     want{attempts: 1, status: http.StatusServiceUnavailable},
+		},
+		"RetryUntilSuccess": {
+			reason:   "Retryable responses should be retried until the request succeeds.",
+			policy:   &RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+			method:   http.MethodGet,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			want:     want{attempts: 3},
+		},
+		"RetriesExhausted": {
+			reason:   "The final response should be handled once retries are exhausted.",
+			policy:   &RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+			method:   http.MethodGet,
+			statuses: []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			want:     want{attempts: 2, status: http.StatusTooManyRequests},
+		},
+		"NotRetryableStatus": {
+			reason:   "Responses with a status that is not retryable should not be retried.",
+			policy:   &RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+			method:   http.MethodGet,
+			statuses: []int{http.StatusInternalServerError, http.StatusOK},
+			want:     want{attempts: 1, status: http.StatusInternalServerError},
+		},
+		"NotIdempotent": {
+			reason:   "Requests with a method that is not idempotent should not be retried.",
+			policy:   &RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+			method:   http.MethodPost,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			want:     want{attempts: 1, status: http.StatusServiceUnavailable},
+		},
+		"RewindBody": {
+			reason:   "The request body should be sent in full on every attempt.",
+			policy:   &RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+			method:   http.MethodPut,
+			body:     map[string]string{"name": "cool"},
+			statuses: []int{http.StatusGatewayTimeout, http.StatusOK},
+			want: want{
+				attempts: 2,
+				bodies:   []string{"{\"name\":\"cool\"}\n", "{\"name\":\"cool\"}\n"},
+			},
+		},
+		"RetryAfter": {
+			reason:   "A Retry-After header should be honoured.",
+			policy:   &RetryPolicy{MaxRetries: 1, MinBackoff: time.Hour, MaxBackoff: time.Hour},
+			method:   http.MethodGet,
+			statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+			header:   http.Header{retryAfterHeader: []string{"0"}},
+			want:     want{attempts: 2},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var attempts atomic.Int32
+			var bodies []string
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+				if b, _ := io.ReadAll(r.Body); len(b) > 0 {
+					bodies = append(bodies, string(b))
+				}
+				for k, v := range tc.header {
+					w.Header()[k] = v
+				}
+				w.WriteHeader(tc.statuses[n-1])
+			}))
+			defer s.Close()
+			u, _ := url.Parse(s.URL)
+			c := NewClient(func(c *HTTPClient) {
+				c.BaseURL = u
+				c.HTTP = s.Client()
+			}, WithRetryPolicy(tc.policy))
+
+			req, err := c.NewRequest(context.Background(), tc.method, "v1", "test", tc.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = c.Do(req, nil)
+			status := 0
+			if err != nil {
+				e := &uerrors.Error{}
+				if !errors.As(err, &e) {
+					t.Fatalf("\n%s\nDo(...): unexpected error: %v", tc.reason, err)
+				}
+				status = e.Status
+			}
+			got := want{attempts: attempts.Load(), status: status, bodies: bodies}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nDo(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDoRetriesContextDeadline(t *testing.T) {
+	var attempts atomic.Int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.Header().Set(retryAfterHeader, "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer s.Close()
+	u, _ := url.Parse(s.URL)
+	c := NewClient(func(c *HTTPClient) {
+		c.BaseURL = u
+		c.HTTP = s.Client()
+	}, WithRetryPolicy(DefaultRetryPolicy()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := c.NewRequest(ctx, http.MethodGet, "v1", "test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Do(req, nil); err == nil {
+		t.Errorf("Do(...): expected error")
+	}
+	if diff := cmp.Diff(int32(1), attempts.Load()); diff != "" {
+		t.Errorf("\n%s\nDo(...): -want attempts, +got attempts:\n%s", "A Retry-After past the context deadline should not be waited for.", diff)
+	}
+}
+
+func TestNewClientRetryPolicy(t *testing.T) {
+	if diff := cmp.Diff(DefaultRetryPolicy(), NewClient().RetryPolicy); diff != "" {
+		t.Errorf("\n%s\nNewClient(...): -want, +got:\n%s", "Clients should retry requests according to the default policy.", diff)
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	cases := map[string]struct {
+		reason string
+		ctx    context.Context
+		err    error
+		want   bool
+	}{
+		"Timeout": {
+			reason: "Network timeouts should be retried.",
+			ctx:    context.Background(),
+			err:    &url.Error{Op: "Get", URL: "https://example.com", Err: &net.OpError{Op: "dial", Err: timeoutError{}}},
+			want:   true,
+		},
+		"ConnectionReset": {
+			reason: "Reset connections should be retried.",
+			ctx:    context.Background(),
+			err:    &url.Error{Op: "Get", URL: "https://example.com", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}},
+			want:   true,
+		},
+		"NoSuchHost": {
+			reason: "Hosts that do not exist should not be retried.",
+			ctx:    context.Background(),
+			err:    &url.Error{Op: "Get", URL: "https://example.com", Err: &net.OpError{Op: "dial", Err: &net.DNSError{Err: "no such host", IsNotFound: true}}},
+			want:   false,
+		},
+		"Canceled": {
+			reason: "Requests whose context was canceled should not be retried.",
+			ctx:    canceled,
+			err:    &url.Error{Op: "Get", URL: "https://example.com", Err: context.Canceled},
+			want:   false,
+		},
+		"DeadlineExceeded": {
+			reason: "Requests whose context deadline was exceeded should not be retried.",
+			ctx:    context.Background(),
+			err:    &url.Error{Op: "Get", URL: "https://example.com", Err: context.DeadlineExceeded},
+			want:   false,
+		},
+		"Permanent": {
+			reason: "Errors that are not temporary should not be retried.",
+			ctx:    context.Background(),
+			err:    &url.Error{Op: "Get", URL: "ftp://example.com", Err: errors.New("unsupported protocol scheme")},
+			want:   false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req, _ := http.NewRequestWithContext(tc.ctx, http.MethodGet, "https://example.com", nil)
+			got := DefaultRetryPolicy().shouldRetry(req, nil, tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nshouldRetry(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// timeoutError is a net.Error that timed out.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	type want struct {
+		d  time.Duration
+		ok bool
+	}
+	cases := map[string]struct {
+		reason string
+		value  string
+		want   want
+	}{
+		"Empty": {
+			reason: "An empty value should not be parsed.",
+			want:   want{},
+		},
+		"Seconds": {
+			reason: "A number of seconds should be parsed.",
+			value:  "120",
+			want:   want{d: 2 * time.Minute, ok: true},
+		},
+		"Negative": {
+			reason: "A negative number of seconds is invalid.",
+			value:  "-1",
+			want:   want{},
+		},
+		"Date": {
+			reason: "An HTTP date should be parsed relative to now.",
+			value:  now.Add(30 * time.Second).Format(http.TimeFormat),
+			want:   want{d: 30 * time.Second, ok: true},
+		},
+		"PastDate": {
+			reason: "An HTTP date in the past should not result in a negative wait.",
+			value:  now.Add(-time.Minute).Format(http.TimeFormat),
+			want:   want{ok: true},
+		},
+		"Invalid": {
+			reason: "An invalid value should not be parsed.",
+			value:  "soon",
+			want:   want{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, ok := parseRetryAfter(tc.value, now)
+			if diff := cmp.Diff(tc.want, want{d: d, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nparseRetryAfter(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	cases := map[string]struct {
+		attempt    int
+		retryAfter string
+		min, max   time.Duration
+	}{
+		"First":            {attempt: 0, min: 50 * time.Millisecond, max: 100 * time.Millisecond},
+		"Second":           {attempt: 1, min: 100 * time.Millisecond, max: 200 * time.Millisecond},
+		"Capped":           {attempt: 10, min: 500 * time.Millisecond, max: time.Second},
+		"RetryAfter":       {attempt: 0, retryAfter: "0", min: 0, max: 0},
+		"RetryAfterCapped": {attempt: 0, retryAfter: "120", min: time.Second, max: time.Second},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var res *http.Response
+			if tc.retryAfter != "" {
+				res = &http.Response{Header: http.Header{retryAfterHeader: []string{tc.retryAfter}}}
+			}
+			for range 100 {
+				if d := p.backoff(tc.attempt, res); d < tc.min || d > tc.max {
+					t.Fatalf("backoff(%d): got %s, want between %s and %s", tc.attempt, d, tc.min, tc.max)
+				}
+			}
+		})
+	}
+}
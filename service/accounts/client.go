@@ -16,9 +16,11 @@ package accounts
 
 import (
 	"context"
+	"iter"
 	"net/http"
 
 	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/common"
 )
 
 const basePath = "v1/accounts"
@@ -62,3 +64,9 @@ func (c *Client) List(ctx context.Context) ([]AccountResponse, error) {
 	}
 	return ns, nil
 }
+
+// ListAll returns an iterator over all accounts for the authenticated user on
+// Upbound.
+func (c *Client) ListAll(ctx context.Context, opts ...common.PagerOption) iter.Seq2[AccountResponse, error] {
+	return common.NewPager(common.SinglePage(c.List), opts...).All(ctx)
+}
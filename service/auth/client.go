@@ -57,12 +57,12 @@ func (c *Client) GetOrgScopedToken(ctx context.Context, org, token string) (*Tok
 		return nil, err
 	}
 
-	reader := strings.NewReader(body.Encode())
+	encoded := body.Encode()
 
-	req.ContentLength = int64(reader.Len())
-	req.Body = io.NopCloser(reader)
+	req.ContentLength = int64(len(encoded))
+	req.Body = io.NopCloser(strings.NewReader(encoded))
 	req.GetBody = func() (io.ReadCloser, error) {
-		return io.NopCloser(reader), nil
+		return io.NopCloser(strings.NewReader(encoded)), nil
 	}
 	req.Header.Set("Content-Type", ContentTypeFormURLEncoded)
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
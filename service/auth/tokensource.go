@@ -0,0 +1,236 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up-sdk-go"
+)
+
+const (
+	// defaultExpiryDelta is how long before its expiry a token is refreshed,
+	// so that it does not expire while a request is in flight.
+	defaultExpiryDelta = 30 * time.Second
+
+	errExchangeToken = "cannot exchange token for organization %q"
+	errSubjectToken  = "cannot get subject token"
+)
+
+// A SubjectTokenFn returns the token that is exchanged for org scoped tokens,
+// such as a user session or personal access token.
+type SubjectTokenFn func(ctx context.Context) (string, error)
+
+// A TokenSource caches org scoped tokens exchanged using GetOrgScopedToken
+// and refreshes them before they expire. It is safe for concurrent use, and
+// concurrent requests for the same organization result in a single exchange.
+type TokenSource struct {
+	client      *Client
+	subject     SubjectTokenFn
+	expiryDelta time.Duration
+	now         func() time.Time
+
+	mu     sync.Mutex
+	tokens map[string]*oauth2.Token
+	group  singleflight.Group
+}
+
+// A TokenSourceOption modifies a TokenSource.
+type TokenSourceOption func(*TokenSource)
+
+// WithExpiryDelta sets how long before their expiry cached tokens are
+// refreshed.
+func WithExpiryDelta(d time.Duration) TokenSourceOption {
+	return func(s *TokenSource) {
+		s.expiryDelta = d
+	}
+}
+
+// WithSubjectTokenFn sets the function used to get the token that is
+// exchanged, overriding the static subject token.
+func WithSubjectTokenFn(fn SubjectTokenFn) TokenSourceOption {
+	return func(s *TokenSource) {
+		s.subject = fn
+	}
+}
+
+// NewTokenSource builds a TokenSource that exchanges the supplied subject
+// token for org scoped tokens using the supplied client.
+func NewTokenSource(c *Client, subject string, opts ...TokenSourceOption) *TokenSource {
+	s := &TokenSource{
+		client: c,
+		subject: func(_ context.Context) (string, error) {
+			return subject, nil
+		},
+		expiryDelta: defaultExpiryDelta,
+		now:         time.Now,
+		tokens:      map[string]*oauth2.Token{},
+	}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+// Token returns a valid org scoped token for the supplied organization,
+// exchanging a new one if there is no cached token or it is about to expire.
+func (s *TokenSource) Token(ctx context.Context, org string) (*oauth2.Token, error) {
+	s.mu.Lock()
+	t, ok := s.tokens[org]
+	s.mu.Unlock()
+	if ok && s.valid(t) {
+		return t, nil
+	}
+	ch := s.group.DoChan(org, func() (interface{}, error) {
+		return s.exchange(ctx, org)
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-ch:
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		return r.Val.(*oauth2.Token), nil //nolint:forcetypeassert // exchange always returns a token.
+	}
+}
+
+// Invalidate removes any cached token for the supplied organization, so that
+// the next call to Token exchanges a new one.
+func (s *TokenSource) Invalidate(org string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tokens, org)
+}
+
+// invalidate removes the cached token for the supplied organization if it is
+// the supplied access token. Tokens that were exchanged since the supplied one
+// are kept.
+func (s *TokenSource) invalidate(org, accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t, ok := s.tokens[org]; ok && t.AccessToken == accessToken {
+		delete(s.tokens, org)
+	}
+}
+
+// ForOrganization returns an oauth2.TokenSource that returns org scoped tokens
+// for the supplied organization.
+func (s *TokenSource) ForOrganization(ctx context.Context, org string) oauth2.TokenSource {
+	return &orgTokenSource{ctx: ctx, source: s, org: org}
+}
+
+// Credentials returns up.Credentials that authenticate requests with org
+// scoped tokens for the supplied organization. They are refreshed when a
+// request is rejected as unauthorized.
+func (s *TokenSource) Credentials(org string) up.CredentialsRefresher {
+	return &orgCredentials{source: s, org: org}
+}
+
+// valid returns true if the token exists and is not about to expire. Tokens
+// without an expiry never expire.
+func (s *TokenSource) valid(t *oauth2.Token) bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	if t.Expiry.IsZero() {
+		return true
+	}
+	return s.now().Add(s.expiryDelta).Before(t.Expiry)
+}
+
+// exchange exchanges the subject token for an org scoped token and caches it.
+func (s *TokenSource) exchange(ctx context.Context, org string) (*oauth2.Token, error) {
+	// The exchange is shared by all callers waiting on it, so it must not be
+	// cancelled just because the caller that started it gave up.
+	ctx = context.WithoutCancel(ctx)
+	subject, err := s.subject(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errSubjectToken)
+	}
+	res, err := s.client.GetOrgScopedToken(ctx, org, subject)
+	if err != nil {
+		return nil, errors.Wrapf(err, errExchangeToken, org)
+	}
+	t := &oauth2.Token{
+		AccessToken: res.AccessToken,
+		TokenType:   res.TokenType,
+	}
+	if res.ExpiresIn > 0 {
+		t.Expiry = s.now().Add(time.Duration(res.ExpiresIn) * time.Second)
+	}
+	s.mu.Lock()
+	s.tokens[org] = t
+	s.mu.Unlock()
+	return t, nil
+}
+
+type orgTokenSource struct {
+	ctx    context.Context //nolint:containedctx // oauth2.TokenSource does not accept a context.
+	source *TokenSource
+	org    string
+}
+
+// Token implements oauth2.TokenSource.
+func (o *orgTokenSource) Token() (*oauth2.Token, error) {
+	return o.source.Token(o.ctx, o.org)
+}
+
+type orgCredentials struct {
+	source *TokenSource
+	org    string
+}
+
+// Apply implements up.Credentials.
+func (o *orgCredentials) Apply(req *http.Request) error {
+	t, err := o.source.Token(req.Context(), o.org)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+t.AccessToken)
+	return nil
+}
+
+// Refresh implements up.CredentialsRefresher. Only the token the rejected
+// request was sent with is invalidated, so that concurrently rejected
+// requests don't discard a token another one just exchanged.
+func (o *orgCredentials) Refresh(ctx context.Context, rejected *http.Request) error {
+	if rejected == nil {
+		o.source.Invalidate(o.org)
+	} else {
+		o.source.invalidate(o.org, strings.TrimPrefix(rejected.Header.Get("Authorization"), "Bearer "))
+	}
+	_, err := o.source.Token(ctx, o.org)
+	return err
+}
+
+// String implements fmt.Stringer without exposing the token.
+func (o *orgCredentials) String() string {
+	return "OrgScopedToken(" + o.org + ")"
+}
+
+// GoString implements fmt.GoStringer without exposing the token.
+func (o *orgCredentials) GoString() string {
+	return o.String()
+}
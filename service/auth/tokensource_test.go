@@ -0,0 +1,212 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/fake"
+)
+
+// exchangeClient returns a mock client that answers token exchanges with a
+// token named after the organization and the number of exchanges so far.
+func exchangeClient(exchanges *atomic.Int32, expiresIn int, err error) *Client {
+	return NewClient(&up.Config{
+		Client: &fake.MockClient{
+			MockNewRequest: func(ctx context.Context, method, _, _ string, _ interface{}) (*http.Request, error) {
+				return http.NewRequestWithContext(ctx, method, "https://auth.upbound.io", nil)
+			},
+			MockDo: func(req *http.Request, obj interface{}) error {
+				n := exchanges.Add(1)
+				// Give concurrent callers a chance to pile up.
+				time.Sleep(10 * time.Millisecond)
+				if err != nil {
+					return err
+				}
+				_ = req.ParseForm()
+				b, _ := json.Marshal(&TokenExchangeResponse{
+					AccessToken: fmt.Sprintf("%s-%d", req.PostForm.Get(ParamScope), n),
+					TokenType:   "Bearer",
+					ExpiresIn:   expiresIn,
+				})
+				return json.Unmarshal(b, obj)
+			},
+		},
+	})
+}
+
+func TestTokenSourceToken(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type want struct {
+		tokens    []string
+		exchanges int32
+		err       error
+	}
+	cases := map[string]struct {
+		reason    string
+		expiresIn int
+		err       error
+		// advance is how far the clock moves between calls.
+		advance time.Duration
+		orgs    []string
+		want    want
+	}{
+		"Cached": {
+			reason:    "A valid token should be cached.",
+			expiresIn: 3600,
+			advance:   time.Minute,
+			orgs:      []string{"cool", "cool"},
+			want: want{
+				tokens:    []string{"upbound:org:cool-1", "upbound:org:cool-1"},
+				exchanges: 1,
+			},
+		},
+		"PerOrganization": {
+			reason:    "Tokens should be cached per organization.",
+			expiresIn: 3600,
+			orgs:      []string{"cool", "neat", "cool"},
+			want: want{
+				tokens:    []string{"upbound:org:cool-1", "upbound:org:neat-2", "upbound:org:cool-1"},
+				exchanges: 2,
+			},
+		},
+		"RefreshedBeforeExpiry": {
+			reason:    "A token that is about to expire should be refreshed.",
+			expiresIn: 60,
+			advance:   45 * time.Second,
+			orgs:      []string{"cool", "cool"},
+			want: want{
+				tokens:    []string{"upbound:org:cool-1", "upbound:org:cool-2"},
+				exchanges: 2,
+			},
+		},
+		"NoExpiry": {
+			reason:  "A token without an expiry should never be refreshed.",
+			advance: 24 * time.Hour,
+			orgs:    []string{"cool", "cool"},
+			want: want{
+				tokens:    []string{"upbound:org:cool-1", "upbound:org:cool-1"},
+				exchanges: 1,
+			},
+		},
+		"ExchangeFailed": {
+			reason: "A failed exchange should return an error.",
+			err:    errBoom,
+			orgs:   []string{"cool"},
+			want: want{
+				exchanges: 1,
+				err:       errors.Wrapf(errBoom, errExchangeToken, "cool"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var exchanges atomic.Int32
+			clock := now
+			s := NewTokenSource(exchangeClient(&exchanges, tc.expiresIn, tc.err), "subject")
+			s.now = func() time.Time { return clock }
+
+			got := want{}
+			for _, org := range tc.orgs {
+				tok, err := s.Token(context.Background(), org)
+				if err != nil {
+					got.err = err
+					break
+				}
+				got.tokens = append(got.tokens, tok.AccessToken)
+				clock = clock.Add(tc.advance)
+			}
+			got.exchanges = exchanges.Load()
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nToken(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTokenSourceSingleFlight(t *testing.T) {
+	var exchanges atomic.Int32
+	s := NewTokenSource(exchangeClient(&exchanges, 3600, nil), "subject")
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := s.Token(context.Background(), "cool"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if diff := cmp.Diff(int32(1), exchanges.Load()); diff != "" {
+		t.Errorf("\n%s\nToken(...): -want exchanges, +got exchanges:\n%s", "Concurrent callers should share a single exchange.", diff)
+	}
+}
+
+func TestTokenSourceCredentials(t *testing.T) {
+	var exchanges atomic.Int32
+	s := NewTokenSource(exchangeClient(&exchanges, 3600, nil), "subject")
+	c := s.Credentials("cool")
+
+	apply := func() *http.Request {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://localhost:8080", nil)
+		if err := c.Apply(req); err != nil {
+			t.Fatal(err)
+		}
+		return req
+	}
+	rejected := apply()
+	if diff := cmp.Diff("Bearer upbound:org:cool-1", rejected.Header.Get("Authorization")); diff != "" {
+		t.Errorf("\n%s\nApply(...): -want, +got:\n%s", "Credentials should apply the org scoped token.", diff)
+	}
+	if err := c.Refresh(context.Background(), rejected); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("Bearer upbound:org:cool-2", apply().Header.Get("Authorization")); diff != "" {
+		t.Errorf("\n%s\nApply(...): -want, +got:\n%s", "Refreshed credentials should apply a new org scoped token.", diff)
+	}
+	if err := c.Refresh(context.Background(), rejected); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("Bearer upbound:org:cool-2", apply().Header.Get("Authorization")); diff != "" {
+		t.Errorf("\n%s\nApply(...): -want, +got:\n%s", "A request rejected with a token that was already replaced should not discard the new token.", diff)
+	}
+
+	var ts oauth2.TokenSource = s.ForOrganization(context.Background(), "cool")
+	tok, err := ts.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("upbound:org:cool-2", tok.AccessToken); diff != "" {
+		t.Errorf("\n%s\nToken(): -want, +got:\n%s", "The oauth2.TokenSource should return the cached token.", diff)
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"strconv"
+)
+
+// PageRequest identifies the page requested from a list endpoint.
+type PageRequest struct {
+	// Page is the zero-based index of the page, for endpoints paginated by
+	// page number.
+	Page int
+
+	// Size is the maximum number of items in the page. Zero means the
+	// endpoint's default.
+	Size int
+
+	// Continue is the continuation token returned with the previous page,
+	// for endpoints paginated by continuation token.
+	Continue string
+
+	// Options are additional options applied to the list request.
+	Options []ListOption
+}
+
+// Apply applies the additional options, page number and size to a list
+// request. The page number and size replace any set by the options.
+func (p PageRequest) Apply(r *http.Request) {
+	for _, o := range p.Options {
+		o(r)
+	}
+	q := r.URL.Query()
+	q.Set(PageParam, strconv.Itoa(p.Page))
+	if p.Size > 0 {
+		q.Set(SizeParam, strconv.Itoa(p.Size))
+	}
+	r.URL.RawQuery = q.Encode()
+}
+
+// PageResponse is a page of items returned by a list endpoint.
+type PageResponse[T any] struct {
+	// Items are the items in the page.
+	Items []T
+
+	// Continue is the continuation token for the next page, for endpoints
+	// paginated by continuation token.
+	Continue string
+
+	// Last is true if there are no more pages.
+	Last bool
+}
+
+// A PageFn fetches a single page from a list endpoint.
+type PageFn[T any] func(ctx context.Context, req PageRequest) (*PageResponse[T], error)
+
+// SinglePage adapts a list endpoint that is not paginated to a PageFn. The
+// endpoint returns all items at once, so a Pager of it only fetches a single
+// page regardless of its page size.
+func SinglePage[T any](fn func(ctx context.Context) ([]T, error)) PageFn[T] {
+	return func(ctx context.Context, _ PageRequest) (*PageResponse[T], error) {
+		items, err := fn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &PageResponse[T]{Items: items, Last: true}, nil
+	}
+}
+
+// IsLastPage returns true if a page of the supplied length is the last page
+// of an endpoint paginated by page number, given the page size reported by
+// the endpoint.
+func IsLastPage(items, size int) bool {
+	return items == 0 || items < size
+}
+
+// PagerOptions configure a Pager.
+type PagerOptions struct {
+	// PageSize is the number of items requested per page. Zero means the
+	// endpoint's default.
+	PageSize int
+
+	// MaxItems is the maximum number of items returned. Zero means all items
+	// are returned.
+	MaxItems int
+
+	// ListOptions are applied to every list request.
+	ListOptions []ListOption
+}
+
+// A PagerOption modifies the options of a Pager.
+type PagerOption func(*PagerOptions)
+
+// WithPageSize sets the number of items requested per page.
+func WithPageSize(size int) PagerOption {
+	return func(o *PagerOptions) {
+		o.PageSize = size
+	}
+}
+
+// WithMaxItems sets the maximum number of items returned.
+func WithMaxItems(n int) PagerOption {
+	return func(o *PagerOptions) {
+		o.MaxItems = n
+	}
+}
+
+// WithListOptions sets additional options, such as filters, that are applied
+// to every list request.
+func WithListOptions(opts ...ListOption) PagerOption {
+	return func(o *PagerOptions) {
+		o.ListOptions = append(o.ListOptions, opts...)
+	}
+}
+
+// A Pager lazily fetches the pages of a list endpoint.
+type Pager[T any] struct {
+	fetch PageFn[T]
+	opts  PagerOptions
+}
+
+// NewPager builds a Pager that fetches pages using the supplied function.
+func NewPager[T any](fn PageFn[T], opts ...PagerOption) *Pager[T] {
+	p := &Pager[T]{fetch: fn}
+	for _, o := range opts {
+		o(&p.opts)
+	}
+	return p
+}
+
+// All returns an iterator over all items of the list endpoint. Pages are only
+// fetched as the iterator is advanced, and iteration may be stopped early. If
+// fetching a page fails the error is yielded and iteration stops.
+func (p *Pager[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		req := PageRequest{Size: p.opts.PageSize, Options: p.opts.ListOptions}
+		n := 0
+		for {
+			res, err := p.fetch(ctx, req)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, i := range res.Items {
+				if !yield(i, nil) {
+					return
+				}
+				n++
+				if p.opts.MaxItems > 0 && n >= p.opts.MaxItems {
+					return
+				}
+			}
+			if res.Last || len(res.Items) == 0 {
+				return
+			}
+			req.Page++
+			req.Continue = res.Continue
+		}
+	}
+}
+
+// Collect fetches all items of the list endpoint.
+func (p *Pager[T]) Collect(ctx context.Context) ([]T, error) {
+	var items []T
+	for i, err := range p.All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	return items, nil
+}
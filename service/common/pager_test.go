@@ -0,0 +1,163 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+)
+
+// pages returns a PageFn serving the supplied pages by page number, recording
+// the requests it receives.
+func pages(reqs *[]PageRequest, size int, p ...[]int) PageFn[int] {
+	return func(_ context.Context, req PageRequest) (*PageResponse[int], error) {
+		*reqs = append(*reqs, req)
+		if req.Page >= len(p) {
+			return &PageResponse[int]{}, nil
+		}
+		return &PageResponse[int]{Items: p[req.Page], Last: IsLastPage(len(p[req.Page]), size)}, nil
+	}
+}
+
+func TestPagerAll(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		items []int
+		pages []int
+		err   error
+	}
+	cases := map[string]struct {
+		reason string
+		fetch  func(reqs *[]PageRequest) PageFn[int]
+		opts   []PagerOption
+		// stop stops iteration after this many items if non-zero.
+		stop int
+		want want
+	}{
+		"AllPages": {
+			reason: "All items of all pages should be returned.",
+			fetch: func(reqs *[]PageRequest) PageFn[int] {
+				return pages(reqs, 2, []int{1, 2}, []int{3, 4}, []int{5})
+			},
+			want: want{items: []int{1, 2, 3, 4, 5}, pages: []int{0, 1, 2}},
+		},
+		"SinglePage": {
+			reason: "Endpoints that are not paginated should be fetched once, limited to the maximum number of items.",
+			fetch: func(reqs *[]PageRequest) PageFn[int] {
+				return SinglePage(func(_ context.Context) ([]int, error) {
+					*reqs = append(*reqs, PageRequest{})
+					return []int{1, 2, 3}, nil
+				})
+			},
+			opts: []PagerOption{WithPageSize(1), WithMaxItems(2)},
+			want: want{items: []int{1, 2}, pages: []int{0}},
+		},
+		"EmptyLastPage": {
+			reason: "Paging should stop at the first empty page.",
+			fetch: func(reqs *[]PageRequest) PageFn[int] {
+				return pages(reqs, 2, []int{1, 2}, []int{3, 4})
+			},
+			want: want{items: []int{1, 2, 3, 4}, pages: []int{0, 1, 2}},
+		},
+		"MaxItems": {
+			reason: "No more than the maximum number of items should be returned, and no more pages fetched.",
+			fetch: func(reqs *[]PageRequest) PageFn[int] {
+				return pages(reqs, 2, []int{1, 2}, []int{3, 4}, []int{5})
+			},
+			opts: []PagerOption{WithMaxItems(3)},
+			want: want{items: []int{1, 2, 3}, pages: []int{0, 1}},
+		},
+		"EarlyTermination": {
+			reason: "Breaking out of the loop should stop fetching pages.",
+			fetch: func(reqs *[]PageRequest) PageFn[int] {
+				return pages(reqs, 2, []int{1, 2}, []int{3, 4}, []int{5})
+			},
+			stop: 2,
+			want: want{items: []int{1, 2}, pages: []int{0}},
+		},
+		"Continue": {
+			reason: "Continuation tokens should be passed to the next page.",
+			fetch: func(reqs *[]PageRequest) PageFn[int] {
+				return func(_ context.Context, req PageRequest) (*PageResponse[int], error) {
+					*reqs = append(*reqs, req)
+					if req.Continue == "" {
+						return &PageResponse[int]{Items: []int{1}, Continue: "next"}, nil
+					}
+					return &PageResponse[int]{Items: []int{2}, Last: true}, nil
+				}
+			},
+			want: want{items: []int{1, 2}, pages: []int{0, 1}},
+		},
+		"Error": {
+			reason: "An error fetching a page should be yielded and stop iteration.",
+			fetch: func(reqs *[]PageRequest) PageFn[int] {
+				return func(_ context.Context, req PageRequest) (*PageResponse[int], error) {
+					*reqs = append(*reqs, req)
+					if req.Page > 0 {
+						return nil, errBoom
+					}
+					return &PageResponse[int]{Items: []int{1}}, nil
+				}
+			},
+			want: want{items: []int{1}, pages: []int{0, 1}, err: errBoom},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var reqs []PageRequest
+			got := want{}
+			for i, err := range NewPager(tc.fetch(&reqs), tc.opts...).All(context.Background()) {
+				if err != nil {
+					got.err = err
+					continue
+				}
+				got.items = append(got.items, i)
+				if tc.stop > 0 && len(got.items) >= tc.stop {
+					break
+				}
+			}
+			for _, r := range reqs {
+				got.pages = append(got.pages, r.Page)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nAll(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPageRequestApply(t *testing.T) {
+	var reqs []PageRequest
+	_, _ = NewPager(pages(&reqs, 30, []int{1}), WithPageSize(30), WithListOptions(func(r *http.Request) {
+		q := r.URL.Query()
+		q.Add("filter", "cool")
+		r.URL.RawQuery = q.Encode()
+	}, WithPage(5), WithSize(10))).Collect(context.Background())
+
+	r, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://localhost:8080", nil)
+	reqs[0].Apply(r)
+	want := url.Values{PageParam: {"0"}, SizeParam: {strconv.Itoa(30)}, "filter": {"cool"}}
+	if diff := cmp.Diff(want, r.URL.Query()); diff != "" {
+		t.Errorf("\n%s\nApply(...): -want, +got:\n%s", "should set page, size and list options on the request, replacing the page and size set by options", diff)
+	}
+}
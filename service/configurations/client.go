@@ -17,9 +17,11 @@ package configurations
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/common"
 )
 
 const (
@@ -39,30 +41,39 @@ func NewClient(cfg *up.Config) *Client {
 
 // List all configurations for an account on Upbound.
 func (c *Client) List(ctx context.Context, account string) (*ConfigurationListResponse, error) {
-	// Call listOnePage repeatedly to get all configurations.
 	configurations := &ConfigurationListResponse{}
-	page := 0
-	for {
-		configs, err := c.listOnePage(ctx, account, page)
+	for cfg, err := range c.ListAll(ctx, account) {
 		if err != nil {
 			return nil, err
 		}
-		configurations.Configurations = append(configurations.Configurations, configs.Configurations...)
-		configurations.Count += configs.Count
-		if configs.Count == 0 || configs.Count < configs.Size {
-			break
-		}
-		page++
+		configurations.Configurations = append(configurations.Configurations, cfg)
+		configurations.Count++
 	}
 	return configurations, nil
 }
 
+// ListAll returns an iterator over all configurations for an account on
+// Upbound. Pages are fetched lazily as the iterator is advanced.
+func (c *Client) ListAll(ctx context.Context, account string, opts ...common.PagerOption) iter.Seq2[ConfigurationResponse, error] {
+	return common.NewPager(func(ctx context.Context, p common.PageRequest) (*common.PageResponse[ConfigurationResponse], error) {
+		res, err := c.listOnePage(ctx, account, p)
+		if err != nil {
+			return nil, err
+		}
+		return &common.PageResponse[ConfigurationResponse]{
+			Items: res.Configurations,
+			Last:  common.IsLastPage(len(res.Configurations), res.Size),
+		}, nil
+	}, opts...).All(ctx)
+}
+
 // listOnePage gets one page of configuration.
-func (c *Client) listOnePage(ctx context.Context, account string, page int) (*ConfigurationListResponse, error) {
-	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, fmt.Sprintf("%s?page=%d", account, page), nil)
+func (c *Client) listOnePage(ctx context.Context, account string, p common.PageRequest) (*ConfigurationListResponse, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, account, nil)
 	if err != nil {
 		return nil, err
 	}
+	p.Apply(req)
 	configurations := &ConfigurationListResponse{}
 	err = c.Client.Do(req, &configurations)
 	if err != nil {
@@ -127,3 +138,14 @@ func (c *Client) ListTemplates(ctx context.Context) (*ConfigurationTemplateListR
 	}
 	return templates, nil
 }
+
+// ListAllTemplates returns an iterator over all configuration templates.
+func (c *Client) ListAllTemplates(ctx context.Context, opts ...common.PagerOption) iter.Seq2[ConfigurationTemplateReponse, error] {
+	return common.NewPager(common.SinglePage(func(ctx context.Context) ([]ConfigurationTemplateReponse, error) {
+		res, err := c.ListTemplates(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return res.Templates, nil
+	}), opts...).All(ctx)
+}
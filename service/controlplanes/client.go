@@ -16,6 +16,7 @@ package controlplanes
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"path"
 
@@ -92,3 +93,18 @@ func (c *Client) Delete(ctx context.Context, account, name string) error { // no
 	}
 	return c.Client.Do(req, nil)
 }
+
+// ListAll returns an iterator over all control planes in the account. Pages
+// are fetched lazily as the iterator is advanced.
+func (c *Client) ListAll(ctx context.Context, account string, opts ...common.PagerOption) iter.Seq2[ControlPlaneResponse, error] {
+	return common.NewPager(func(ctx context.Context, p common.PageRequest) (*common.PageResponse[ControlPlaneResponse], error) {
+		res, err := c.List(ctx, account, p.Apply)
+		if err != nil {
+			return nil, err
+		}
+		return &common.PageResponse[ControlPlaneResponse]{
+			Items: res.ControlPlanes,
+			Last:  common.IsLastPage(len(res.ControlPlanes), res.Size),
+		}, nil
+	}, opts...).All(ctx)
+}
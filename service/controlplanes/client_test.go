@@ -389,3 +389,71 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestListAll(t *testing.T) {
+	errBoom := errors.New("boom")
+	testURL, _ := url.Parse("https://localhost:8080")
+	cp := func(name string) ControlPlaneResponse {
+		return ControlPlaneResponse{ControlPlane: ControlPlane{Name: name}}
+	}
+	pages := [][]ControlPlaneResponse{{cp("a"), cp("b")}, {cp("c")}}
+
+	type want struct {
+		names []string
+		pages []string
+		err   error
+	}
+	cases := map[string]struct {
+		reason string
+		fail   bool
+		opts   []common.PagerOption
+		want   want
+	}{
+		"AllPages": {
+			reason: "All control planes on all pages should be returned.",
+			want:   want{names: []string{"a", "b", "c"}, pages: []string{"0", "1"}},
+		},
+		"MaxItems": {
+			reason: "Pages should not be fetched beyond the maximum number of items.",
+			opts:   []common.PagerOption{common.WithMaxItems(2)},
+			want:   want{names: []string{"a", "b"}, pages: []string{"0"}},
+		},
+		"DoFailed": {
+			reason: "Failing to execute request should return an error.",
+			fail:   true,
+			want:   want{pages: []string{"0"}, err: errBoom},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := NewClient(&up.Config{
+				Client: &fake.MockClient{
+					MockNewRequest: func(ctx context.Context, _, _, _ string, _ interface{}) (*http.Request, error) {
+						return http.NewRequestWithContext(ctx, http.MethodGet, testURL.String(), nil)
+					},
+					MockDo: func(req *http.Request, obj interface{}) error {
+						page := req.URL.Query().Get(common.PageParam)
+						got.pages = append(got.pages, page)
+						if tc.fail {
+							return errBoom
+						}
+						i, _ := strconv.Atoi(page)
+						*obj.(*ControlPlaneListResponse) = ControlPlaneListResponse{ControlPlanes: pages[i], Size: 2}
+						return nil
+					},
+				},
+			})
+			for cp, err := range c.ListAll(context.Background(), "upbound", tc.opts...) {
+				if err != nil {
+					got.err = err
+					break
+				}
+				got.names = append(got.names, cp.ControlPlane.Name)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nListAll(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
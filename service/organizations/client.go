@@ -18,11 +18,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"path"
 	"strconv"
 
 	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/common"
 )
 
 const (
@@ -191,3 +193,41 @@ func (c *Client) RemoveMember(ctx context.Context, orgID uint, userID uint) erro
 	}
 	return c.Client.Do(req, nil)
 }
+
+// ListAll returns an iterator over all organizations for the authenticated
+// user on Upbound.
+func (c *Client) ListAll(ctx context.Context, opts ...common.PagerOption) iter.Seq2[Organization, error] {
+	return common.NewPager(common.SinglePage(c.List), opts...).All(ctx)
+}
+
+// ListAllRobots returns an iterator over all robots the user can access in
+// the organization on Upbound.
+func (c *Client) ListAllRobots(ctx context.Context, id uint, opts ...common.PagerOption) iter.Seq2[Robot, error] {
+	return common.NewPager(common.SinglePage(func(ctx context.Context) ([]Robot, error) {
+		return c.ListRobots(ctx, id)
+	}), opts...).All(ctx)
+}
+
+// ListAllTeams returns an iterator over all teams the user can access in the
+// organization on Upbound.
+func (c *Client) ListAllTeams(ctx context.Context, id uint, opts ...common.PagerOption) iter.Seq2[Team, error] {
+	return common.NewPager(common.SinglePage(func(ctx context.Context) ([]Team, error) {
+		return c.ListTeams(ctx, id)
+	}), opts...).All(ctx)
+}
+
+// ListAllInvites returns an iterator over all invites for the organization on
+// Upbound.
+func (c *Client) ListAllInvites(ctx context.Context, orgID uint, opts ...common.PagerOption) iter.Seq2[Invite, error] {
+	return common.NewPager(common.SinglePage(func(ctx context.Context) ([]Invite, error) {
+		return c.ListInvites(ctx, orgID)
+	}), opts...).All(ctx)
+}
+
+// ListAllMembers returns an iterator over all members for the organization on
+// Upbound.
+func (c *Client) ListAllMembers(ctx context.Context, orgID uint, opts ...common.PagerOption) iter.Seq2[Member, error] {
+	return common.NewPager(common.SinglePage(func(ctx context.Context) ([]Member, error) {
+		return c.ListMembers(ctx, orgID)
+	}), opts...).All(ctx)
+}
@@ -0,0 +1,747 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package query contains a builder and client for the Spaces query API.
+package query
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/theory/jsonpath"
+	"github.com/theory/jsonpath/spec"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+// An OrderField is a field objects can be ordered by.
+type OrderField string
+
+// Fields objects can be ordered by.
+const (
+	OrderByCreationTimestamp OrderField = "creationTimestamp"
+	OrderByName              OrderField = "name"
+	OrderByNamespace         OrderField = "namespace"
+	OrderByAPIGroup          OrderField = "apiGroup"
+	OrderByKind              OrderField = "kind"
+	OrderByGroup             OrderField = "group"
+	OrderByControlPlane      OrderField = "controlPlane"
+)
+
+var (
+	orderFields = sets.New(OrderByCreationTimestamp, OrderByName, OrderByNamespace, OrderByAPIGroup, OrderByKind, OrderByGroup, OrderByControlPlane)
+)
+
+// A Builder builds a QuerySpec. Filter methods such as Kind and WithCondition
+// add criteria to the current filter, all of which must match. Or starts a new
+// filter; objects are returned if they match any filter.
+//
+// Arguments are validated as the query is built, and any errors are returned
+// when it is emitted, for example by Build or SpaceQuery.
+type Builder struct {
+	resources    *resources
+	controlPlane queryv1alpha2.QueryFilterControlPlane
+}
+
+// New returns a Builder for a query that matches all objects.
+func New() *Builder {
+	return &Builder{resources: newResources(field.NewPath("spec"), field.NewPath("spec", "filter", "objects"))}
+}
+
+// InControlPlane restricts the query to the control plane with the supplied
+// group and name. Either may be empty to query all groups or control planes.
+func (b *Builder) InControlPlane(group, name string) *Builder {
+	b.controlPlane = queryv1alpha2.QueryFilterControlPlane{Group: group, Name: name}
+	return b
+}
+
+// Or starts a new filter. Objects matching any filter are returned.
+func (b *Builder) Or() *Builder {
+	b.resources.or()
+	return b
+}
+
+// ID restricts the current filter to the object with the supplied ID.
+func (b *Builder) ID(id string) *Builder {
+	b.resources.id(id)
+	return b
+}
+
+// Kind restricts the current filter to objects of the supplied API group and
+// kind. Kinds are case-insensitive and also match plural resources. An empty
+// group matches all groups.
+func (b *Builder) Kind(group, kind string) *Builder {
+	b.resources.kind(group, kind)
+	return b
+}
+
+// InNamespace restricts the current filter to objects in the supplied
+// namespace within a control plane.
+func (b *Builder) InNamespace(namespace string) *Builder {
+	b.resources.namespace(namespace)
+	return b
+}
+
+// Named restricts the current filter to objects with the supplied name.
+func (b *Builder) Named(name string) *Builder {
+	b.resources.name(name)
+	return b
+}
+
+// WithLabels restricts the current filter to objects with the supplied
+// labels.
+func (b *Builder) WithLabels(labels map[string]string) *Builder {
+	b.resources.labels(labels)
+	return b
+}
+
+// InCategories restricts the current filter to objects in the supplied
+// categories, for example managed or composite.
+func (b *Builder) InCategories(categories ...string) *Builder {
+	b.resources.categories(categories)
+	return b
+}
+
+// WithCondition restricts the current filter to objects with a condition of
+// the supplied type and status. An empty status matches any status.
+func (b *Builder) WithCondition(conditionType, status string) *Builder {
+	b.resources.condition(queryv1alpha2.QueryCondition{Type: conditionType, Status: status})
+	return b
+}
+
+// WithConditionReason restricts the current filter to objects with a
+// condition of the supplied type, status and reason.
+func (b *Builder) WithConditionReason(conditionType, status, reason string) *Builder {
+	b.resources.condition(queryv1alpha2.QueryCondition{Type: conditionType, Status: status, Reason: reason})
+	return b
+}
+
+// CreatedBetween restricts the current filter to objects created in the
+// supplied time range. A zero time leaves that end of the range open.
+func (b *Builder) CreatedBetween(after, before time.Time) *Builder {
+	b.resources.created(after, before)
+	return b
+}
+
+// JSONPath restricts the current filter to objects for which the supplied
+// JSONPath filter expression returns true. It should be used as a last
+// resort; other filters are generally more efficient.
+func (b *Builder) JSONPath(expr string) *Builder {
+	b.resources.jsonPath(expr)
+	return b
+}
+
+// Select returns the supplied fields of each object, for example .metadata
+// or .status.conditions. A path of "." selects the whole object.
+func (b *Builder) Select(paths ...string) *Builder {
+	b.resources.selectPaths(paths)
+	return b
+}
+
+// ReturnID returns the opaque ID of each object.
+func (b *Builder) ReturnID() *Builder {
+	b.resources.objects().ID = true
+	return b
+}
+
+// ReturnMutablePath returns the path of each object in the control plane's
+// Kubernetes API.
+func (b *Builder) ReturnMutablePath() *Builder {
+	b.resources.objects().MutablePath = true
+	return b
+}
+
+// ReturnControlPlane returns the control plane of each object.
+func (b *Builder) ReturnControlPlane() *Builder {
+	b.resources.objects().ControlPlane = true
+	return b
+}
+
+// Table returns the objects as tables, grouped as supplied.
+func (b *Builder) Table(grouping queryv1alpha2.QueryGrouping) *Builder {
+	b.resources.objects().Table = &queryv1alpha2.QueryTable{Grouping: grouping}
+	return b
+}
+
+// Relation returns objects related to each object, for example events or
+// owners, as built by the supplied RelationBuilder.
+func (b *Builder) Relation(name string, r *RelationBuilder) *Builder {
+	b.resources.relation(name, r)
+	return b
+}
+
+// OrderBy orders objects by the supplied field. Calls are cumulative; the
+// first call specifies the primary order.
+func (b *Builder) OrderBy(f OrderField, d queryv1alpha2.Direction) *Builder {
+	b.resources.orderBy(f, d)
+	return b
+}
+
+// Limit limits the number of objects returned. Zero means the server's
+// default.
+func (b *Builder) Limit(n int) *Builder {
+	b.resources.limit(n)
+	return b
+}
+
+// Count returns the number of matching objects. Computing the count is
+// expensive, so it should only be requested if necessary.
+func (b *Builder) Count() *Builder {
+	b.resources.res.Count = true
+	return b
+}
+
+// Cursor returns a cursor to the next page of objects.
+func (b *Builder) Cursor() *Builder {
+	b.resources.res.Cursor = true
+	return b
+}
+
+// Page starts returning objects at the supplied cursor, as returned by a
+// previous query.
+func (b *Builder) Page(cursor string) *Builder {
+	b.resources.res.Page.Cursor = cursor
+	return b
+}
+
+// Skip skips the supplied number of objects, relative to the page cursor if
+// any.
+func (b *Builder) Skip(n int) *Builder {
+	b.resources.skip(n)
+	return b
+}
+
+// Build returns the QuerySpec, or any errors encountered while building it.
+func (b *Builder) Build() (*queryv1alpha2.QuerySpec, error) {
+	if err := b.resources.errors().ToAggregate(); err != nil {
+		return nil, err
+	}
+	s := &queryv1alpha2.QuerySpec{
+		QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+			QueryResources: b.resources.build(),
+			Filter: queryv1alpha2.QueryTopLevelFilter{
+				ControlPlane: b.controlPlane,
+				Objects:      b.resources.filters,
+			},
+		},
+	}
+	// Don't share state with the builder, which may be used to build more
+	// queries.
+	return s.DeepCopy(), nil
+}
+
+// SpaceQuery returns a SpaceQuery, which queries all control planes in a
+// Space.
+func (b *Builder) SpaceQuery() (*queryv1alpha2.SpaceQuery, error) {
+	s, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return &queryv1alpha2.SpaceQuery{
+		TypeMeta: metav1.TypeMeta{APIVersion: queryv1alpha2.SchemeGroupVersion.String(), Kind: queryv1alpha2.SpacesQueryKind},
+		Spec:     s,
+	}, nil
+}
+
+// GroupQuery returns a GroupQuery, which queries the control planes in the
+// supplied group.
+func (b *Builder) GroupQuery(group string) (*queryv1alpha2.GroupQuery, error) {
+	s, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return &queryv1alpha2.GroupQuery{
+		TypeMeta:   metav1.TypeMeta{APIVersion: queryv1alpha2.SchemeGroupVersion.String(), Kind: queryv1alpha2.GroupQueryKind},
+		ObjectMeta: metav1.ObjectMeta{Namespace: group},
+		Spec:       s,
+	}, nil
+}
+
+// Query returns a Query, which queries the control plane with the supplied
+// group and name.
+func (b *Builder) Query(group, controlPlane string) (*queryv1alpha2.Query, error) {
+	s, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return &queryv1alpha2.Query{
+		TypeMeta:   metav1.TypeMeta{APIVersion: queryv1alpha2.SchemeGroupVersion.String(), Kind: queryv1alpha2.QueryKind},
+		ObjectMeta: metav1.ObjectMeta{Namespace: group, Name: controlPlane},
+		Spec:       s,
+	}, nil
+}
+
+// A RelationBuilder builds the objects returned for a relation of a query,
+// such as events or owners. Its filter methods behave like those of Builder.
+type RelationBuilder struct {
+	resources *resources
+}
+
+// NewRelation returns a RelationBuilder for a relation that returns all
+// related objects.
+func NewRelation() *RelationBuilder {
+	return &RelationBuilder{resources: newResources(nil, nil)}
+}
+
+// Or starts a new filter. Related objects matching any filter are returned.
+func (r *RelationBuilder) Or() *RelationBuilder {
+	r.resources.or()
+	return r
+}
+
+// Kind restricts the current filter to objects of the supplied API group and
+// kind.
+func (r *RelationBuilder) Kind(group, kind string) *RelationBuilder {
+	r.resources.kind(group, kind)
+	return r
+}
+
+// InNamespace restricts the current filter to objects in the supplied
+// namespace.
+func (r *RelationBuilder) InNamespace(namespace string) *RelationBuilder {
+	r.resources.namespace(namespace)
+	return r
+}
+
+// Named restricts the current filter to objects with the supplied name.
+func (r *RelationBuilder) Named(name string) *RelationBuilder {
+	r.resources.name(name)
+	return r
+}
+
+// WithLabels restricts the current filter to objects with the supplied
+// labels.
+func (r *RelationBuilder) WithLabels(labels map[string]string) *RelationBuilder {
+	r.resources.labels(labels)
+	return r
+}
+
+// InCategories restricts the current filter to objects in the supplied
+// categories.
+func (r *RelationBuilder) InCategories(categories ...string) *RelationBuilder {
+	r.resources.categories(categories)
+	return r
+}
+
+// WithCondition restricts the current filter to objects with a condition of
+// the supplied type and status.
+func (r *RelationBuilder) WithCondition(conditionType, status string) *RelationBuilder {
+	r.resources.condition(queryv1alpha2.QueryCondition{Type: conditionType, Status: status})
+	return r
+}
+
+// JSONPath restricts the current filter to objects for which the supplied
+// JSONPath filter expression returns true.
+func (r *RelationBuilder) JSONPath(expr string) *RelationBuilder {
+	r.resources.jsonPath(expr)
+	return r
+}
+
+// Select returns the supplied fields of each related object.
+func (r *RelationBuilder) Select(paths ...string) *RelationBuilder {
+	r.resources.selectPaths(paths)
+	return r
+}
+
+// ReturnID returns the opaque ID of each related object.
+func (r *RelationBuilder) ReturnID() *RelationBuilder {
+	r.resources.objects().ID = true
+	return r
+}
+
+// ReturnMutablePath returns the path of each related object in the control
+// plane's Kubernetes API.
+func (r *RelationBuilder) ReturnMutablePath() *RelationBuilder {
+	r.resources.objects().MutablePath = true
+	return r
+}
+
+// Relation returns objects related to each related object.
+func (r *RelationBuilder) Relation(name string, nested *RelationBuilder) *RelationBuilder {
+	r.resources.relation(name, nested)
+	return r
+}
+
+// OrderBy orders related objects by the supplied field.
+func (r *RelationBuilder) OrderBy(f OrderField, d queryv1alpha2.Direction) *RelationBuilder {
+	r.resources.orderBy(f, d)
+	return r
+}
+
+// Limit limits the number of related objects returned across all parents.
+func (r *RelationBuilder) Limit(n int) *RelationBuilder {
+	r.resources.limit(n)
+	return r
+}
+
+// Count returns the number of related objects.
+func (r *RelationBuilder) Count() *RelationBuilder {
+	r.resources.res.Count = true
+	return r
+}
+
+// build returns the relation, or any errors encountered while building it
+// relative to the supplied path.
+func (r *RelationBuilder) build(pth *field.Path) (queryv1alpha2.QueryRelation, field.ErrorList) {
+	r.resources.rebase(pth, pth.Child("filters"))
+	if errs := r.resources.errors(); len(errs) > 0 {
+		return queryv1alpha2.QueryRelation{}, errs
+	}
+	var filters []queryv1alpha2.QueryFilter
+	if len(r.resources.filters) > 1 || !isEmptyFilter(r.resources.filters[0]) {
+		filters = r.resources.filters
+	}
+	return queryv1alpha2.QueryRelation{
+		QueryNestedResources: queryv1alpha2.QueryNestedResources{
+			QueryResources: r.resources.build(),
+			Filters:        filters,
+		},
+	}, nil
+}
+
+// A pathError returns errors whose field paths are only known once the query
+// is built, because they were found in a relation.
+type pathError func(resources, filters *field.Path) field.ErrorList
+
+// resources accumulates the resources, filters and errors common to top level
+// queries and relations.
+type resources struct {
+	path        *field.Path
+	filtersPath *field.Path
+
+	res       queryv1alpha2.QueryResources
+	filters   []queryv1alpha2.QueryFilter
+	object    interface{}
+	relations map[string]*RelationBuilder
+	errs      []pathError
+}
+
+func newResources(pth, filtersPath *field.Path) *resources {
+	return &resources{path: pth, filtersPath: filtersPath, filters: []queryv1alpha2.QueryFilter{{}}}
+}
+
+// rebase sets the paths errors are reported relative to.
+func (r *resources) rebase(pth, filtersPath *field.Path) {
+	r.path, r.filtersPath = pth, filtersPath
+}
+
+// current returns the current filter, and a function that returns its path.
+func (r *resources) current() (*queryv1alpha2.QueryFilter, func(*field.Path) *field.Path) {
+	i := len(r.filters) - 1
+	return &r.filters[i], func(p *field.Path) *field.Path { return p.Index(i) }
+}
+
+func (r *resources) filterError(child string, fn func(*field.Path) *field.Error) {
+	r.filterErrors(child, single(fn))
+}
+
+func (r *resources) filterErrors(child string, fn func(*field.Path) field.ErrorList) {
+	_, idx := r.current()
+	r.errs = append(r.errs, func(_, filters *field.Path) field.ErrorList {
+		return fn(idx(filters).Child(child))
+	})
+}
+
+func (r *resources) resourcesError(child string, fn func(*field.Path) *field.Error) {
+	r.resourcesErrors(child, single(fn))
+}
+
+func (r *resources) resourcesErrors(child string, fn func(*field.Path) field.ErrorList) {
+	r.errs = append(r.errs, func(res, _ *field.Path) field.ErrorList {
+		return fn(res.Child(child))
+	})
+}
+
+// single adapts a function returning an optional error to one returning a
+// list of errors.
+func single(fn func(*field.Path) *field.Error) func(*field.Path) field.ErrorList {
+	return func(p *field.Path) field.ErrorList {
+		if err := fn(p); err != nil {
+			return field.ErrorList{err}
+		}
+		return nil
+	}
+}
+
+func (r *resources) or() {
+	r.filters = append(r.filters, queryv1alpha2.QueryFilter{})
+}
+
+func (r *resources) id(id string) {
+	f, _ := r.current()
+	f.ID = id
+}
+
+func (r *resources) kind(group, kind string) {
+	if kind == "" && group == "" {
+		r.filterError("groupKind", func(p *field.Path) *field.Error {
+			return field.Required(p, "either group or kind must be set")
+		})
+	}
+	f, _ := r.current()
+	f.GroupKind = queryv1alpha2.QueryGroupKind{APIGroup: group, Kind: kind}
+}
+
+func (r *resources) namespace(ns string) {
+	for _, msg := range validation.IsDNS1123Label(ns) {
+		r.filterError("namespace", func(p *field.Path) *field.Error {
+			return field.Invalid(p, ns, msg)
+		})
+	}
+	f, _ := r.current()
+	f.Namespace = ns
+}
+
+func (r *resources) name(name string) {
+	if name == "" {
+		r.filterError("name", func(p *field.Path) *field.Error {
+			return field.Required(p, "")
+		})
+	}
+	f, _ := r.current()
+	f.Name = name
+}
+
+func (r *resources) labels(labels map[string]string) {
+	f, _ := r.current()
+	if f.Labels == nil {
+		f.Labels = map[string]string{}
+	}
+	for k, v := range labels {
+		for _, msg := range validation.IsQualifiedName(k) {
+			r.filterError("labels", func(p *field.Path) *field.Error {
+				return field.Invalid(p.Key(k), k, msg)
+			})
+		}
+		for _, msg := range validation.IsValidLabelValue(v) {
+			r.filterError("labels", func(p *field.Path) *field.Error {
+				return field.Invalid(p.Key(k), v, msg)
+			})
+		}
+		f.Labels[k] = v
+	}
+}
+
+func (r *resources) categories(categories []string) {
+	f, _ := r.current()
+	for _, c := range categories {
+		if c == "" {
+			i := len(f.Categories)
+			r.filterError("categories", func(p *field.Path) *field.Error {
+				return field.Required(p.Index(i), "")
+			})
+		}
+		f.Categories = append(f.Categories, c)
+	}
+}
+
+func (r *resources) condition(c queryv1alpha2.QueryCondition) {
+	f, _ := r.current()
+	i := len(f.Conditions)
+	r.filterErrors("conditions", func(p *field.Path) field.ErrorList {
+		return queryv1alpha2.ValidateCondition(p.Index(i), &c)
+	})
+	f.Conditions = append(f.Conditions, c)
+}
+
+func (r *resources) created(after, before time.Time) {
+	ct := queryv1alpha2.QueryCreationTimestamp{After: metav1.NewTime(after), Before: metav1.NewTime(before)}
+	r.filterErrors("creationTimestamp", func(p *field.Path) field.ErrorList {
+		return queryv1alpha2.ValidateCreationTimestamp(p, &ct)
+	})
+	f, _ := r.current()
+	f.CreationTimestamp = ct
+}
+
+func (r *resources) jsonPath(expr string) {
+	r.filterError("jsonpath", func(p *field.Path) *field.Error {
+		return queryv1alpha2.ValidateFilterExpression(p, expr)
+	})
+	f, _ := r.current()
+	f.JSONPath = expr
+}
+
+func (r *resources) objects() *queryv1alpha2.QueryObjects {
+	if r.res.Objects == nil {
+		r.res.Objects = &queryv1alpha2.QueryObjects{}
+	}
+	return r.res.Objects
+}
+
+func (r *resources) selectPaths(paths []string) {
+	r.objects()
+	for _, p := range paths {
+		names, err := fieldNames(p)
+		if err != nil {
+			r.resourcesError("objects", func(pth *field.Path) *field.Error {
+				return field.Invalid(pth.Child("object"), p, err.Error())
+			})
+			continue
+		}
+		r.object = selectFields(r.object, names)
+	}
+}
+
+func (r *resources) relation(name string, rel *RelationBuilder) {
+	if name == "" {
+		r.resourcesError("objects", func(p *field.Path) *field.Error {
+			return field.Required(p.Child("relations"), "relation name must not be empty")
+		})
+		return
+	}
+	r.objects()
+	if r.relations == nil {
+		r.relations = map[string]*RelationBuilder{}
+	}
+	r.relations[name] = rel
+}
+
+func (r *resources) orderBy(f OrderField, d queryv1alpha2.Direction) {
+	i := len(r.res.Order)
+	o := queryv1alpha2.QueryOrder{}
+	switch f {
+	case OrderByCreationTimestamp:
+		o.CreationTimestamp = d
+	case OrderByName:
+		o.Name = d
+	case OrderByNamespace:
+		o.Namespace = d
+	case OrderByAPIGroup:
+		o.APIGroup = d
+	case OrderByKind:
+		o.Kind = d
+	case OrderByGroup:
+		o.Group = d
+	case OrderByControlPlane:
+		o.ControlPlane = d
+	default:
+		r.resourcesError("order", func(p *field.Path) *field.Error {
+			return field.NotSupported(p.Index(i), f, sets.List(orderFields))
+		})
+		r.res.Order = append(r.res.Order, o)
+		return
+	}
+	r.resourcesErrors("order", func(p *field.Path) field.ErrorList {
+		return queryv1alpha2.ValidateOrder(p.Index(i), &o)
+	})
+	r.res.Order = append(r.res.Order, o)
+}
+
+func (r *resources) limit(n int) {
+	if n < 0 {
+		r.resourcesError("limit", func(p *field.Path) *field.Error {
+			return field.Invalid(p, n, "must not be negative")
+		})
+	}
+	r.res.Limit = n
+}
+
+func (r *resources) skip(n int) {
+	if n < 0 {
+		r.resourcesError("page", func(p *field.Path) *field.Error {
+			return field.Invalid(p.Child("first"), n, "must not be negative")
+		})
+	}
+	r.res.Page.First = n
+}
+
+// errors returns the errors encountered while building the resources and
+// their relations.
+func (r *resources) errors() field.ErrorList {
+	errs := make(field.ErrorList, 0, len(r.errs))
+	for _, fn := range r.errs {
+		errs = append(errs, fn(r.path, r.filtersPath)...)
+	}
+	for _, name := range sets.List(sets.KeySet(r.relations)) {
+		_, rerrs := r.relations[name].build(r.path.Child("objects", "relations").Key(name))
+		errs = append(errs, rerrs...)
+	}
+	return errs
+}
+
+// build returns the resources. It must only be called if there are no errors.
+func (r *resources) build() queryv1alpha2.QueryResources {
+	res := *r.res.DeepCopy()
+	if res.Objects == nil {
+		return res
+	}
+	if r.object != nil {
+		res.Objects.Object = &common.JSON{Object: r.object}
+	}
+	if len(r.relations) > 0 {
+		res.Objects.Relations = make(map[string]queryv1alpha2.QueryRelation, len(r.relations))
+		for name, rel := range r.relations {
+			res.Objects.Relations[name], _ = rel.build(r.path.Child("objects", "relations").Key(name))
+		}
+	}
+	return res
+}
+
+func isEmptyFilter(f queryv1alpha2.QueryFilter) bool {
+	return f.ID == "" && f.Namespace == "" && f.Name == "" && f.JSONPath == "" &&
+		f.GroupKind == (queryv1alpha2.QueryGroupKind{}) &&
+		f.CreationTimestamp.After.IsZero() && f.CreationTimestamp.Before.IsZero() &&
+		len(f.Labels) == 0 && len(f.Categories) == 0 && len(f.Conditions) == 0
+}
+
+// fieldNames returns the field names of a path of name selectors, such as
+// .status.conditions. The path "." has no field names.
+func fieldNames(p string) ([]string, error) {
+	if p == "" {
+		return nil, fmt.Errorf("must not be empty")
+	}
+	if p == "." {
+		return nil, nil
+	}
+	if p[0] == '$' {
+		return nil, fmt.Errorf("must not start with '$'")
+	}
+	jp, err := jsonpath.Parse("$" + p)
+	if err != nil {
+		return nil, fmt.Errorf("must be a valid JSONPath expression: %w", err)
+	}
+	segs := jp.Query().Segments()
+	names := make([]string, 0, len(segs))
+	for _, seg := range segs {
+		sels := seg.Selectors()
+		if seg.IsDescendant() || len(sels) != 1 {
+			return nil, fmt.Errorf("must only contain name selectors, found: %s", seg.String())
+		}
+		n, ok := sels[0].(spec.Name)
+		if !ok {
+			return nil, fmt.Errorf("must only contain name selectors, found: %s", seg.String())
+		}
+		names = append(names, string(n))
+	}
+	return names, nil
+}
+
+// selectFields adds the supplied field path to a sparse object skeleton, where
+// true selects a field and all its descendants.
+func selectFields(skeleton interface{}, names []string) interface{} {
+	if len(names) == 0 || skeleton == true {
+		return true
+	}
+	m, ok := skeleton.(map[string]interface{})
+	if !ok {
+		m = map[string]interface{}{}
+	}
+	m[names[0]] = selectFields(m[names[0]], names[1:])
+	return m
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+func TestBuilderBuild(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type want struct {
+		spec *queryv1alpha2.QuerySpec
+		errs field.ErrorList
+	}
+	cases := map[string]struct {
+		reason  string
+		builder *Builder
+		want    want
+	}{
+		"Empty": {
+			reason:  "An empty query should match all objects.",
+			builder: New(),
+			want: want{
+				spec: &queryv1alpha2.QuerySpec{
+					QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+						Filter: queryv1alpha2.QueryTopLevelFilter{Objects: []queryv1alpha2.QueryFilter{{}}},
+					},
+				},
+			},
+		},
+		"Full": {
+			reason: "All criteria should be set on the query.",
+			builder: New().
+				InControlPlane("default", "ctp").
+				Kind("apps", "Deployment").
+				WithCondition("Ready", "False").
+				Or().
+				Kind("", "Pod").
+				InNamespace("kube-system").
+				WithLabels(map[string]string{"app": "cool"}).
+				JSONPath("@.spec.replicas > 1").
+				Select(".metadata", ".status.conditions", ".status.conditions.type").
+				ReturnID().
+				Relation("events", NewRelation().Kind("", "Event").Select(".message").Limit(10)).
+				OrderBy(OrderByName, queryv1alpha2.Ascending).
+				OrderBy(OrderByControlPlane, queryv1alpha2.Descending).
+				Limit(100).
+				Cursor(),
+			want: want{
+				spec: &queryv1alpha2.QuerySpec{
+					QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+						QueryResources: queryv1alpha2.QueryResources{
+							Objects: &queryv1alpha2.QueryObjects{
+								ID: true,
+								Object: &common.JSON{Object: map[string]interface{}{
+									"metadata": true,
+									"status":   map[string]interface{}{"conditions": true},
+								}},
+								Relations: map[string]queryv1alpha2.QueryRelation{
+									"events": {QueryNestedResources: queryv1alpha2.QueryNestedResources{
+										QueryResources: queryv1alpha2.QueryResources{
+											Objects: &queryv1alpha2.QueryObjects{
+												Object: &common.JSON{Object: map[string]interface{}{"message": true}},
+											},
+											Limit: 10,
+										},
+										Filters: []queryv1alpha2.QueryFilter{{GroupKind: queryv1alpha2.QueryGroupKind{Kind: "Event"}}},
+									}},
+								},
+							},
+							Order: []queryv1alpha2.QueryOrder{
+								{Name: queryv1alpha2.Ascending},
+								{ControlPlane: queryv1alpha2.Descending},
+							},
+							Limit:  100,
+							Cursor: true,
+						},
+						Filter: queryv1alpha2.QueryTopLevelFilter{
+							ControlPlane: queryv1alpha2.QueryFilterControlPlane{Group: "default", Name: "ctp"},
+							Objects: []queryv1alpha2.QueryFilter{
+								{
+									GroupKind:  queryv1alpha2.QueryGroupKind{APIGroup: "apps", Kind: "Deployment"},
+									Conditions: []queryv1alpha2.QueryCondition{{Type: "Ready", Status: "False"}},
+								},
+								{
+									GroupKind: queryv1alpha2.QueryGroupKind{Kind: "Pod"},
+									Namespace: "kube-system",
+									Labels:    map[string]string{"app": "cool"},
+									JSONPath:  "@.spec.replicas > 1",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"SelectWholeObject": {
+			reason:  "Selecting . should return whole objects.",
+			builder: New().Select(".metadata.name", "."),
+			want: want{
+				spec: &queryv1alpha2.QuerySpec{
+					QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+						QueryResources: queryv1alpha2.QueryResources{
+							Objects: &queryv1alpha2.QueryObjects{Object: &common.JSON{Object: true}},
+						},
+						Filter: queryv1alpha2.QueryTopLevelFilter{Objects: []queryv1alpha2.QueryFilter{{}}},
+					},
+				},
+			},
+		},
+		"Invalid": {
+			reason: "Invalid arguments should be reported with their field paths.",
+			builder: New().
+				Kind("", "").
+				WithCondition("", "Maybe").
+				Or().
+				JSONPath("$.spec[?").
+				CreatedBetween(t0, t0).
+				Select(".spec..name").
+				Relation("events", NewRelation().Limit(-1)).
+				OrderBy(OrderByName, "Sideways"),
+			want: want{
+				errs: field.ErrorList{
+					field.Required(field.NewPath("spec", "filter", "objects").Index(0).Child("groupKind"), "either group or kind must be set"),
+					field.Required(field.NewPath("spec", "filter", "objects").Index(0).Child("conditions").Index(0).Child("type"), ""),
+					field.NotSupported(field.NewPath("spec", "filter", "objects").Index(0).Child("conditions").Index(0).Child("status"), "Maybe", []string{"False", "True", "Unknown"}),
+					field.Invalid(field.NewPath("spec", "filter", "objects").Index(1).Child("jsonpath"), "$.spec[?", ""),
+					field.Invalid(field.NewPath("spec", "filter", "objects").Index(1).Child("creationTimestamp", "before"), metav1.NewTime(t0), ""),
+					field.Invalid(field.NewPath("spec", "objects", "object"), ".spec..name", ""),
+					field.NotSupported(field.NewPath("spec", "order").Index(0).Child("name"), queryv1alpha2.Direction("Sideways"), []queryv1alpha2.Direction{queryv1alpha2.Ascending, queryv1alpha2.Descending}),
+					field.Invalid(field.NewPath("spec", "objects", "relations").Key("events").Child("limit"), -1, "must not be negative"),
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.builder.Build()
+			var errs field.ErrorList
+			if err != nil {
+				for _, e := range err.(interface{ Errors() []error }).Errors() { //nolint:forcetypeassert,errorlint // Build returns an aggregate.
+					errs = append(errs, e.(*field.Error)) //nolint:forcetypeassert,errorlint // The aggregate contains field errors.
+				}
+			}
+			if diff := cmp.Diff(tc.want.spec, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nBuild(): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.errs, errs, cmpopts.IgnoreFields(field.Error{}, "Detail")); diff != "" {
+				t.Errorf("\n%s\nBuild(): -want errors, +got errors:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBuilderQueries(t *testing.T) {
+	b := New().Kind("", "Pod")
+
+	sq, err := b.SpaceQuery()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(metav1.TypeMeta{APIVersion: "query.spaces.upbound.io/v1alpha2", Kind: "SpaceQuery"}, sq.TypeMeta); diff != "" {
+		t.Errorf("\n%s\nSpaceQuery(): -want, +got:\n%s", "A SpaceQuery should be returned.", diff)
+	}
+
+	gq, err := b.GroupQuery("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("default", gq.GetNamespace()); diff != "" {
+		t.Errorf("\n%s\nGroupQuery(...): -want, +got:\n%s", "A GroupQuery should be in the group's namespace.", diff)
+	}
+
+	q, err := b.Query("default", "ctp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(metav1.ObjectMeta{Namespace: "default", Name: "ctp"}, q.ObjectMeta); diff != "" {
+		t.Errorf("\n%s\nQuery(...): -want, +got:\n%s", "A Query should be named after its control plane.", diff)
+	}
+
+	q.Spec.Filter.Objects[0].Name = "changed"
+	s, _ := b.Build()
+	if diff := cmp.Diff("", s.Filter.Objects[0].Name); diff != "" {
+		t.Errorf("\n%s\nBuild(): -want, +got:\n%s", "Built queries should not share state with the builder.", diff)
+	}
+}
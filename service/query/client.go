@@ -0,0 +1,275 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up-sdk-go"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+	"github.com/upbound/up-sdk-go/service/spaces"
+)
+
+const (
+	errFmtUnsupportedQuery = "unsupported query type %T"
+	errNoSpec              = "query has no spec"
+	errNoResponse          = "query returned no response"
+)
+
+// Query resources, relative to the Space API.
+const (
+	SpaceQueryResource = "spacequeries"
+	GroupQueryResource = "groupqueries"
+	QueryResource      = "queries"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(queryv1alpha2.AddToScheme(scheme))
+}
+
+// Queries can only be created, so their clients are never used to list
+// objects.
+type (
+	spaceQueries = spaces.ResourceClient[*queryv1alpha2.SpaceQuery, *metav1.List]
+	groupQueries = spaces.ResourceClient[*queryv1alpha2.GroupQuery, *metav1.List]
+	queries      = spaces.ResourceClient[*queryv1alpha2.Query, *metav1.List]
+)
+
+// Client is a client for the Spaces query API. It must be configured with
+// the URL of a Space API.
+type Client struct {
+	spaceQueries *spaceQueries
+	groupQueries *groupQueries
+	queries      *queries
+}
+
+// NewClient creates a new query client.
+func NewClient(cfg *up.Config) *Client {
+	gv := queryv1alpha2.SchemeGroupVersion
+	s := spaces.WithScheme(scheme)
+	return &Client{
+		spaceQueries: spaces.NewResourceClient[*queryv1alpha2.SpaceQuery, *metav1.List](cfg, gv.WithResource(SpaceQueryResource), s, spaces.WithClusterScope()),
+		groupQueries: spaces.NewResourceClient[*queryv1alpha2.GroupQuery, *metav1.List](cfg, gv.WithResource(GroupQueryResource), s),
+		queries:      spaces.NewResourceClient[*queryv1alpha2.Query, *metav1.List](cfg, gv.WithResource(QueryResource), s),
+	}
+}
+
+// Do posts a SpaceQuery, GroupQuery or Query and returns the single page of
+// its response.
+func (c *Client) Do(ctx context.Context, q runtime.Object) (*queryv1alpha2.QueryResponse, error) {
+	var (
+		res *queryv1alpha2.QueryResponse
+		err error
+	)
+	switch q := q.(type) {
+	case *queryv1alpha2.SpaceQuery:
+		var out *queryv1alpha2.SpaceQuery
+		if out, err = c.spaceQueries.Create(ctx, "", q, nil); err == nil {
+			res = out.Response
+		}
+	case *queryv1alpha2.GroupQuery:
+		var out *queryv1alpha2.GroupQuery
+		if out, err = c.groupQueries.Create(ctx, q.GetNamespace(), q, nil); err == nil {
+			res = out.Response
+		}
+	case *queryv1alpha2.Query:
+		var out *queryv1alpha2.Query
+		if out, err = c.queries.Create(ctx, q.GetNamespace(), q, nil); err == nil {
+			res = out.Response
+		}
+	default:
+		return nil, errors.Errorf(errFmtUnsupportedQuery, q)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New(errNoResponse)
+	}
+	return res, nil
+}
+
+// Run returns the Results of a SpaceQuery, GroupQuery or Query. No request
+// is made until the results are iterated.
+func (c *Client) Run(q runtime.Object) *Results {
+	return &Results{client: c, query: q}
+}
+
+// An ObjectError is yielded for objects the query server could not process
+// completely, for example because a selected field could not be read. The
+// object is still yielded, but may be incomplete.
+type ObjectError struct {
+	// ID of the object, if it was requested.
+	ID string
+
+	// Errors reported for the object.
+	Errors []string
+}
+
+// Error implements error.
+func (e *ObjectError) Error() string {
+	id := e.ID
+	if id == "" {
+		id = "unknown"
+	}
+	return fmt.Sprintf("query object %s: %s", id, strings.Join(e.Errors, "; "))
+}
+
+// Results are the results of a query. Pages are fetched transparently by
+// following the cursor returned with each page.
+type Results struct {
+	client *Client
+	query  runtime.Object
+
+	warnings   []string
+	incomplete bool
+	count      *int
+}
+
+// Objects returns an iterator over all objects returned by the query. Pages
+// are fetched as the iterator is advanced, and iteration may be stopped
+// early.
+//
+// Objects the query server reported errors for are yielded together with an
+// *ObjectError, and iteration continues. If fetching a page fails the error
+// is yielded with a zero object and iteration stops.
+func (r *Results) Objects(ctx context.Context) iter.Seq2[queryv1alpha2.QueryResponseObject, error] {
+	return func(yield func(queryv1alpha2.QueryResponseObject, error) bool) {
+		r.warnings, r.incomplete, r.count = nil, false, nil
+		q, spec, err := withCursor(r.query, "")
+		if err != nil {
+			yield(queryv1alpha2.QueryResponseObject{}, err)
+			return
+		}
+		seen := map[string]bool{}
+		for page := 0; ; page++ {
+			res, err := r.client.Do(ctx, q)
+			if err != nil {
+				yield(queryv1alpha2.QueryResponseObject{}, err)
+				return
+			}
+			for _, w := range res.Warnings {
+				if !seen[w] {
+					seen[w] = true
+					r.warnings = append(r.warnings, w)
+				}
+			}
+			next := ""
+			if res.Cursor != nil {
+				next = res.Cursor.Next
+			}
+			if page == 0 {
+				r.count = res.Count
+				// Responses to queries with a cursor are always reported
+				// as incomplete, so only the first page is meaningful.
+				r.incomplete = res.Incomplete && spec.Page.Cursor == "" && spec.Page.First == 0 && next == ""
+			}
+			for i, o := range res.Objects {
+				var oerr error
+				if len(o.Errors) > 0 {
+					oerr = &ObjectError{ID: o.ID, Errors: o.Errors}
+				}
+				if !yield(o, oerr) {
+					r.incomplete = r.incomplete || i < len(res.Objects)-1 || next != ""
+					return
+				}
+			}
+			if next == "" || len(res.Objects) == 0 {
+				return
+			}
+			if q, _, err = withCursor(r.query, next); err != nil {
+				yield(queryv1alpha2.QueryResponseObject{}, err)
+				return
+			}
+		}
+	}
+}
+
+// Collect returns all objects returned by the query. Objects the query
+// server reported errors for are returned, and their errors are ignored.
+func (r *Results) Collect(ctx context.Context) ([]queryv1alpha2.QueryResponseObject, error) {
+	var objs []queryv1alpha2.QueryResponseObject
+	for o, err := range r.Objects(ctx) {
+		if err != nil && !isObjectError(err) {
+			return nil, err
+		}
+		objs = append(objs, o)
+	}
+	return objs, nil
+}
+
+// Warnings returns the distinct warnings returned with any page of the
+// query. They are only complete once the results have been iterated.
+func (r *Results) Warnings() []string {
+	return r.warnings
+}
+
+// Incomplete returns true if not all objects matching the query were
+// returned, either because the query server limited the response or because
+// iteration was stopped early.
+func (r *Results) Incomplete() bool {
+	return r.incomplete
+}
+
+// Count returns the number of matching objects reported with the first page,
+// if the query requested a count.
+func (r *Results) Count() (int, bool) {
+	if r.count == nil {
+		return 0, false
+	}
+	return *r.count, true
+}
+
+func isObjectError(err error) bool {
+	oe := &ObjectError{}
+	return errors.As(err, &oe)
+}
+
+// withCursor returns a copy of the query that requests a cursor and, if the
+// supplied cursor is not empty, starts at it.
+func withCursor(q runtime.Object, cursor string) (runtime.Object, *queryv1alpha2.QuerySpec, error) {
+	q = q.DeepCopyObject()
+	var spec *queryv1alpha2.QuerySpec
+	switch q := q.(type) {
+	case *queryv1alpha2.SpaceQuery:
+		spec = q.Spec
+	case *queryv1alpha2.GroupQuery:
+		spec = q.Spec
+	case *queryv1alpha2.Query:
+		spec = q.Spec
+	default:
+		return nil, nil, errors.Errorf(errFmtUnsupportedQuery, q)
+	}
+	if spec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	orig := spec.DeepCopy()
+	spec.Cursor = true
+	if cursor != "" {
+		spec.Page = queryv1alpha2.QueryPage{Cursor: cursor}
+	}
+	return q, orig, nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+)
+
+func newTestClient(t *testing.T, h http.HandlerFunc) *Client {
+	t.Helper()
+	s := httptest.NewServer(h)
+	t.Cleanup(s.Close)
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewClient(up.NewConfig(func(cfg *up.Config) {
+		cfg.Client = up.NewClient(func(c *up.HTTPClient) {
+			c.BaseURL = u
+			c.HTTP = s.Client()
+		})
+	}))
+}
+
+// pagedServer serves the supplied pages of a GroupQuery, linking each page to
+// the next with a cursor.
+func pagedServer(t *testing.T, pages ...queryv1alpha2.QueryResponse) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/apis/query.spaces.upbound.io/v1alpha2/namespaces/default/groupqueries" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		q := &queryv1alpha2.GroupQuery{}
+		if err := json.NewDecoder(r.Body).Decode(q); err != nil {
+			t.Fatal(err)
+		}
+		if !q.Spec.Cursor {
+			t.Errorf("query did not request a cursor")
+		}
+		i := 0
+		if c := q.Spec.Page.Cursor; c != "" {
+			i = int(c[0] - '0')
+		}
+		res := pages[i].DeepCopy()
+		if i < len(pages)-1 {
+			res.Cursor = &queryv1alpha2.QueryResponseCursor{Next: string(rune('0' + i + 1))}
+		}
+		q.Response = res
+		_ = json.NewEncoder(w).Encode(q)
+	}
+}
+
+func TestResultsObjects(t *testing.T) {
+	count := 3
+	type want struct {
+		ids        []string
+		errs       []string
+		warnings   []string
+		incomplete bool
+		count      int
+	}
+	cases := map[string]struct {
+		reason string
+		pages  []queryv1alpha2.QueryResponse
+		stop   int
+		want   want
+	}{
+		"FollowCursor": {
+			reason: "All pages should be fetched by following the cursor, and warnings deduplicated.",
+			pages: []queryv1alpha2.QueryResponse{
+				{
+					Warnings:             []string{"slow"},
+					QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: []queryv1alpha2.QueryResponseObject{{ID: "a"}, {ID: "b"}}, Count: &count},
+				},
+				{
+					Warnings:             []string{"slow", "stale"},
+					QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: []queryv1alpha2.QueryResponseObject{{ID: "c"}}, Incomplete: true},
+				},
+			},
+			want: want{
+				ids:      []string{"a", "b", "c"},
+				errs:     []string{"", "", ""},
+				warnings: []string{"slow", "stale"},
+				count:    3,
+			},
+		},
+		"ObjectErrors": {
+			reason: "Objects with errors should be yielded with an ObjectError.",
+			pages: []queryv1alpha2.QueryResponse{
+				{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: []queryv1alpha2.QueryResponseObject{
+					{ID: "a", Errors: []string{"cannot read field"}},
+					{ID: "b"},
+				}}},
+			},
+			want: want{
+				ids:  []string{"a", "b"},
+				errs: []string{"query object a: cannot read field", ""},
+			},
+		},
+		"Incomplete": {
+			reason: "A response limited by the server should be reported as incomplete.",
+			pages: []queryv1alpha2.QueryResponse{
+				{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: []queryv1alpha2.QueryResponseObject{{ID: "a"}}, Incomplete: true}},
+			},
+			want: want{
+				ids:        []string{"a"},
+				errs:       []string{""},
+				incomplete: true,
+			},
+		},
+		"StopEarly": {
+			reason: "Stopping before the last page should be reported as incomplete.",
+			pages: []queryv1alpha2.QueryResponse{
+				{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: []queryv1alpha2.QueryResponseObject{{ID: "a"}}}},
+				{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: []queryv1alpha2.QueryResponseObject{{ID: "b"}}}},
+			},
+			stop: 1,
+			want: want{
+				ids:        []string{"a"},
+				errs:       []string{""},
+				incomplete: true,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newTestClient(t, pagedServer(t, tc.pages...))
+			q, err := New().Kind("", "Pod").ReturnID().GroupQuery("default")
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := c.Run(q)
+
+			got := want{}
+			for o, err := range r.Objects(context.Background()) {
+				got.ids = append(got.ids, o.ID)
+				msg := ""
+				if err != nil {
+					msg = err.Error()
+				}
+				got.errs = append(got.errs, msg)
+				if tc.stop > 0 && len(got.ids) == tc.stop {
+					break
+				}
+			}
+			got.warnings = r.Warnings()
+			got.incomplete = r.Incomplete()
+			got.count, _ = r.Count()
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nObjects(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if q.Spec.Cursor {
+				t.Errorf("\n%s\nObjects(...): modified caller's query", tc.reason)
+			}
+		})
+	}
+}
+
+func TestClientDo(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/query.spaces.upbound.io/v1alpha2/spacequeries" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusForbidden)
+		_ = json.NewEncoder(w).Encode(&metav1.Status{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Status"}, Status: metav1.StatusFailure, Reason: metav1.StatusReasonForbidden, Message: "denied", Code: http.StatusForbidden})
+	})
+	q, err := New().SpaceQuery()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.Run(q).Collect(context.Background())
+	if !apierrors.IsForbidden(err) {
+		t.Errorf("\n%s\nCollect(...): want Forbidden error, got %v", "Errors from the Space API should be returned.", err)
+	}
+	if msg := errMessage(err); !strings.HasPrefix(msg, "denied (POST ") || !strings.Contains(msg, "/v1alpha2/spacequeries, request ID ") {
+		t.Errorf("\n%s\nCollect(...): got message %q", "Errors should include the request that resulted in them.", msg)
+	}
+}
+
+func errMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func TestDecoderObjects(t *testing.T) {
+	c := newTestClient(t, pagedServer(t,
+		queryv1alpha2.QueryResponse{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: []queryv1alpha2.QueryResponseObject{
+			{ID: "a", Object: &common.JSONObject{Object: map[string]interface{}{"apiVersion": "spaces.upbound.io/v1beta1", "kind": "ControlPlane"}}},
+		}}},
+	))
+	q, err := New().Kind("spaces.upbound.io", "ControlPlane").GroupQuery("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for o, err := range NewDecoder().Objects(c.Run(q).Objects(context.Background())) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cp, ok := o.Object.(*spacesv1beta1.ControlPlane); ok {
+			got = append(got, o.ID+":"+cp.Kind)
+		}
+	}
+	if diff := cmp.Diff([]string{"a:ControlPlane"}, got); diff != "" {
+		t.Errorf("\n%s\nObjects(...): -want, +got:\n%s", "Streamed objects should be decoded into their Go types.", diff)
+	}
+}
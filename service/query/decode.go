@@ -0,0 +1,195 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"iter"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up-sdk-go/apis"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+const (
+	errFmtDecodeObject   = "cannot decode query object %q"
+	errFmtDecodeRelation = "cannot decode relation %q"
+)
+
+// defaultScheme knows every API defined in this project.
+var defaultScheme = sync.OnceValue(func() *runtime.Scheme {
+	s := runtime.NewScheme()
+	utilruntime.Must(apis.AddToScheme(s))
+	return s
+})
+
+// An Object is an object returned by a query, decoded into its Go type.
+type Object struct {
+	// ID of the object, if it was requested.
+	ID string
+
+	// MutablePath of the object, if it was requested.
+	MutablePath *queryv1alpha2.QueryResponseMutablePath
+
+	// ControlPlane of the object, if it was requested.
+	ControlPlane *queryv1alpha2.QueryResponseControlPlane
+
+	// Object is the decoded object. It is of the Go type registered for its
+	// kind, or *unstructured.Unstructured if the kind is unknown. It is nil if
+	// the query did not select any fields of the object. Objects are sparse
+	// unless the whole object was selected.
+	Object runtime.Object
+
+	// Errors reported by the query server for the object.
+	Errors []string
+
+	// Relations of the object, decoded recursively.
+	Relations map[string][]Object
+}
+
+// A DecoderOption modifies a Decoder.
+type DecoderOption func(*Decoder)
+
+// WithScheme sets the scheme a Decoder uses to find the Go types of objects.
+func WithScheme(s *runtime.Scheme) DecoderOption {
+	return func(d *Decoder) {
+		d.scheme = s
+	}
+}
+
+// A Decoder decodes objects returned by a query into their Go types.
+type Decoder struct {
+	scheme *runtime.Scheme
+}
+
+// NewDecoder returns a Decoder. By default it uses a scheme that knows every
+// API defined in this project.
+func NewDecoder(opts ...DecoderOption) *Decoder {
+	d := &Decoder{}
+	for _, fn := range opts {
+		fn(d)
+	}
+	if d.scheme == nil {
+		d.scheme = defaultScheme()
+	}
+	return d
+}
+
+// DecodeAll decodes the supplied objects.
+func (d *Decoder) DecodeAll(objs []queryv1alpha2.QueryResponseObject) ([]Object, error) {
+	out := make([]Object, 0, len(objs))
+	for _, o := range objs {
+		do, err := d.Decode(o)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *do)
+	}
+	return out, nil
+}
+
+// Objects decodes the objects yielded by the supplied iterator, for example
+// one returned by Results.Objects. Errors yielded by the iterator are passed
+// through together with the decoded object. If an object cannot be decoded
+// the error is yielded with a nil object.
+func (d *Decoder) Objects(objs iter.Seq2[queryv1alpha2.QueryResponseObject, error]) iter.Seq2[*Object, error] {
+	return func(yield func(*Object, error) bool) {
+		for o, oerr := range objs {
+			if oerr != nil && !isObjectError(oerr) {
+				yield(nil, oerr)
+				return
+			}
+			do, err := d.Decode(o)
+			if err != nil {
+				oerr = err
+			}
+			if !yield(do, oerr) {
+				return
+			}
+		}
+	}
+}
+
+// Decode decodes the supplied object and its relations. The kind of the
+// object is taken from its apiVersion and kind if they were selected, or else
+// from the resource of its mutable path.
+func (d *Decoder) Decode(o queryv1alpha2.QueryResponseObject) (*Object, error) {
+	out := &Object{
+		ID:           o.ID,
+		MutablePath:  o.MutablePath,
+		ControlPlane: o.ControlPlane,
+		Errors:       o.Errors,
+	}
+	if o.Object != nil {
+		obj, err := d.decodeObject(o)
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtDecodeObject, o.ID)
+		}
+		out.Object = obj
+	}
+	if len(o.Relations) > 0 {
+		out.Relations = make(map[string][]Object, len(o.Relations))
+	}
+	for name, r := range o.Relations {
+		objs, err := d.DecodeAll(r.Objects)
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtDecodeRelation, name)
+		}
+		out.Relations[name] = objs
+	}
+	return out, nil
+}
+
+func (d *Decoder) decodeObject(o queryv1alpha2.QueryResponseObject) (runtime.Object, error) {
+	u := &unstructured.Unstructured{Object: o.Object.DeepCopy().Object}
+	gvk := u.GroupVersionKind()
+	if gvk.Kind == "" && o.MutablePath != nil {
+		gvk = d.kindFor(schema.GroupVersionResource(o.MutablePath.GroupVersionResource))
+	}
+	if gvk.Kind == "" {
+		return u, nil
+	}
+	u.SetGroupVersionKind(gvk)
+	if !d.scheme.Recognizes(gvk) {
+		return u, nil
+	}
+	obj, err := d.scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// kindFor returns the kind known to the scheme that is served as the supplied
+// resource, or only its group and version if there is none.
+func (d *Decoder) kindFor(gvr schema.GroupVersionResource) schema.GroupVersionKind {
+	for kind := range d.scheme.KnownTypes(gvr.GroupVersion()) {
+		gvk := gvr.GroupVersion().WithKind(kind)
+		if plural, _ := meta.UnsafeGuessKindToResource(gvk); plural == gvr {
+			return gvk
+		}
+	}
+	return gvr.GroupVersion().WithKind("")
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+)
+
+func TestDecode(t *testing.T) {
+	cpPath := &queryv1alpha2.QueryResponseMutablePath{
+		GroupVersionResource: metav1.GroupVersionResource{Group: "spaces.upbound.io", Version: "v1beta1", Resource: "controlplanes"},
+	}
+	type want struct {
+		obj *Object
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		o      queryv1alpha2.QueryResponseObject
+		want   want
+	}{
+		"Typed": {
+			reason: "Objects with a registered apiVersion and kind should be decoded into their Go type.",
+			o: queryv1alpha2.QueryResponseObject{
+				ID: "a",
+				Object: &common.JSONObject{Object: map[string]interface{}{
+					"apiVersion": "spaces.upbound.io/v1beta1",
+					"kind":       "ControlPlane",
+					"metadata":   map[string]interface{}{"name": "cool", "generation": float64(2)},
+				}},
+			},
+			want: want{obj: &Object{
+				ID: "a",
+				Object: &spacesv1beta1.ControlPlane{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "spaces.upbound.io/v1beta1", Kind: "ControlPlane"},
+					ObjectMeta: metav1.ObjectMeta{Name: "cool", Generation: 2},
+				},
+			}},
+		},
+		"MutablePath": {
+			reason: "The kind of sparse objects should be found from their mutable path.",
+			o: queryv1alpha2.QueryResponseObject{
+				MutablePath: cpPath,
+				Object:      &common.JSONObject{Object: map[string]interface{}{"metadata": map[string]interface{}{"name": "cool"}}},
+			},
+			want: want{obj: &Object{
+				MutablePath: cpPath,
+				Object: &spacesv1beta1.ControlPlane{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "spaces.upbound.io/v1beta1", Kind: "ControlPlane"},
+					ObjectMeta: metav1.ObjectMeta{Name: "cool"},
+				},
+			}},
+		},
+		"Unregistered": {
+			reason: "Objects of unregistered kinds should be decoded as unstructured, with relations decoded recursively.",
+			o: queryv1alpha2.QueryResponseObject{
+				Object: &common.JSONObject{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Pod"}},
+				Relations: map[string]queryv1alpha2.QueryResponseRelation{
+					"owners": {QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Objects: []queryv1alpha2.QueryResponseObject{
+						{MutablePath: cpPath, Errors: []string{"boom"}},
+					}}},
+				},
+			},
+			want: want{obj: &Object{
+				Object: &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Pod"}},
+				Relations: map[string][]Object{
+					"owners": {{MutablePath: cpPath, Errors: []string{"boom"}}},
+				},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewDecoder().Decode(tc.o)
+			if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("\n%s\nDecode(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, got); diff != "" {
+				t.Errorf("\n%s\nDecode(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDecodeWithScheme(t *testing.T) {
+	o := queryv1alpha2.QueryResponseObject{
+		Object: &common.JSONObject{Object: map[string]interface{}{"apiVersion": "spaces.upbound.io/v1beta1", "kind": "ControlPlane"}},
+	}
+	got, err := NewDecoder(WithScheme(runtime.NewScheme())).Decode(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := got.Object.(*unstructured.Unstructured); !ok {
+		t.Errorf("\n%s\nDecode(...): got %T", "Kinds unknown to the supplied scheme should be decoded as unstructured.", got.Object)
+	}
+}
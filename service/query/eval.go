@@ -0,0 +1,458 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/theory/jsonpath"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+const (
+	errFmtInvalidJSONPath = "invalid jsonpath %q"
+	errFmtInvalidOrder    = "invalid order %d: exactly one field must be set to %s or %s"
+	errInvalidCursor      = "invalid cursor"
+
+	warnFreshness = "freshness is ignored by the in-memory evaluator"
+	warnRelations = "relations are not evaluated by the in-memory evaluator"
+	warnTable     = "tables are not rendered by the in-memory evaluator"
+)
+
+// defaultLimit is the number of objects returned if a query sets no limit.
+const defaultLimit = 100
+
+// An InMemoryObject is an object known to an Evaluator.
+type InMemoryObject struct {
+	// ControlPlane the object exists in. Its namespace is the control plane's
+	// group.
+	ControlPlane queryv1alpha2.QueryResponseControlPlane
+
+	// Object itself.
+	Object *unstructured.Unstructured
+}
+
+// An EvaluatorOption modifies an Evaluator.
+type EvaluatorOption func(*Evaluator)
+
+// WithCategories sets the categories of a kind, e.g. managed or composite.
+func WithCategories(gk schema.GroupKind, categories ...string) EvaluatorOption {
+	return func(e *Evaluator) {
+		e.categories[gk] = categories
+	}
+}
+
+// An Evaluator evaluates queries against a set of objects in memory. It is a
+// reference implementation of the query semantics, intended for testing code
+// that builds or consumes queries without a Space.
+//
+// Filters, projections, ordering, paging and counts are evaluated. Relations,
+// tables and freshness are not; queries that use them return a warning.
+type Evaluator struct {
+	objects    []InMemoryObject
+	categories map[schema.GroupKind][]string
+}
+
+// NewEvaluator returns an Evaluator for the supplied objects.
+func NewEvaluator(objs []InMemoryObject, opts ...EvaluatorOption) *Evaluator {
+	e := &Evaluator{objects: objs, categories: map[schema.GroupKind][]string{}}
+	for _, fn := range opts {
+		fn(e)
+	}
+	return e
+}
+
+// Do evaluates a SpaceQuery, GroupQuery or Query. GroupQueries only match
+// objects in control planes of their namespace, and Queries only match objects
+// in the control plane of their name and namespace.
+func (e *Evaluator) Do(_ context.Context, q runtime.Object) (*queryv1alpha2.QueryResponse, error) {
+	var (
+		spec  *queryv1alpha2.QuerySpec
+		scope queryv1alpha2.QueryFilterControlPlane
+	)
+	switch q := q.(type) {
+	case *queryv1alpha2.SpaceQuery:
+		spec = q.Spec
+	case *queryv1alpha2.GroupQuery:
+		spec, scope = q.Spec, queryv1alpha2.QueryFilterControlPlane{Group: q.GetNamespace()}
+	case *queryv1alpha2.Query:
+		spec, scope = q.Spec, queryv1alpha2.QueryFilterControlPlane{Group: q.GetNamespace(), Name: q.GetName()}
+	default:
+		return nil, errors.Errorf(errFmtUnsupportedQuery, q)
+	}
+	if spec == nil {
+		return nil, errors.New(errNoSpec)
+	}
+	return e.evaluate(spec, scope)
+}
+
+// Evaluate evaluates a query against all objects.
+func (e *Evaluator) Evaluate(spec *queryv1alpha2.QuerySpec) (*queryv1alpha2.QueryResponse, error) {
+	return e.evaluate(spec, queryv1alpha2.QueryFilterControlPlane{})
+}
+
+func (e *Evaluator) evaluate(spec *queryv1alpha2.QuerySpec, scope queryv1alpha2.QueryFilterControlPlane) (*queryv1alpha2.QueryResponse, error) {
+	res := &queryv1alpha2.QueryResponse{}
+	if len(spec.Freshness) > 0 {
+		res.Warnings = append(res.Warnings, warnFreshness)
+	}
+	if o := spec.Objects; o != nil {
+		if len(o.Relations) > 0 {
+			res.Warnings = append(res.Warnings, warnRelations)
+		}
+		if o.Table != nil {
+			res.Warnings = append(res.Warnings, warnTable)
+		}
+	}
+
+	filters, err := compileFilters(spec.Filter.Objects)
+	if err != nil {
+		return nil, err
+	}
+	var matches []InMemoryObject
+	for _, o := range e.objects {
+		if !inControlPlane(o, scope) || !inControlPlane(o, spec.Filter.ControlPlane) {
+			continue
+		}
+		if len(filters) == 0 || slices.ContainsFunc(filters, func(f filter) bool { return e.matches(o, f) }) {
+			matches = append(matches, o)
+		}
+	}
+	if err := sortObjects(matches, spec.Order); err != nil {
+		return nil, err
+	}
+
+	start, err := decodeCursor(spec.Page.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	start = min(start+max(spec.Page.First, 0), len(matches))
+	limit := spec.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	end := min(start+limit, len(matches))
+
+	if spec.Count {
+		n := len(matches)
+		res.Count = &n
+	}
+	res.Incomplete = end < len(matches) || spec.Page.Cursor != "" || spec.Page.First > 0
+	if spec.Cursor {
+		res.Cursor = &queryv1alpha2.QueryResponseCursor{Page: start / limit, PageSize: limit, Position: start}
+		if end < len(matches) {
+			res.Cursor.Next = encodeCursor(end)
+		}
+	}
+	if spec.Objects == nil {
+		return res, nil
+	}
+	res.Objects = make([]queryv1alpha2.QueryResponseObject, 0, end-start)
+	for _, o := range matches[start:end] {
+		res.Objects = append(res.Objects, respond(o, spec.Objects))
+	}
+	return res, nil
+}
+
+// A filter is a QueryFilter with its JSONPath expression parsed.
+type filter struct {
+	queryv1alpha2.QueryFilter
+	jsonpath *jsonpath.Path
+}
+
+func compileFilters(fs []queryv1alpha2.QueryFilter) ([]filter, error) {
+	out := make([]filter, len(fs))
+	for i, f := range fs {
+		out[i].QueryFilter = f
+		if f.JSONPath == "" {
+			continue
+		}
+		expr := f.JSONPath
+		if !strings.HasPrefix(expr, "$") {
+			expr = "$[?" + expr + "]"
+		}
+		p, err := jsonpath.Parse(expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtInvalidJSONPath, f.JSONPath)
+		}
+		out[i].jsonpath = p
+	}
+	return out, nil
+}
+
+func inControlPlane(o InMemoryObject, cp queryv1alpha2.QueryFilterControlPlane) bool {
+	return (cp.Group == "" || cp.Group == o.ControlPlane.Namespace) && (cp.Name == "" || cp.Name == o.ControlPlane.Name)
+}
+
+// matches returns true if the object matches all criteria of the filter.
+func (e *Evaluator) matches(o InMemoryObject, f filter) bool { //nolint:gocyclo // A flat list of criteria.
+	u := o.Object
+	gvk := u.GroupVersionKind()
+	switch {
+	case f.ID != "" && f.ID != objectID(o):
+		return false
+	case f.Namespace != "" && f.Namespace != u.GetNamespace():
+		return false
+	case f.Name != "" && f.Name != u.GetName():
+		return false
+	case f.GroupKind.APIGroup != "" && f.GroupKind.APIGroup != gvk.Group:
+		return false
+	case f.GroupKind.Kind != "" && !matchesKind(gvk, f.GroupKind.Kind):
+		return false
+	case !matchesCreationTimestamp(u.GetCreationTimestamp(), f.CreationTimestamp):
+		return false
+	case len(f.Categories) > 0 && !slices.ContainsFunc(f.Categories, func(c string) bool {
+		return slices.Contains(e.categories[gvk.GroupKind()], c)
+	}):
+		return false
+	}
+	labels := u.GetLabels()
+	for k, v := range f.Labels {
+		if lv, ok := labels[k]; !ok || lv != v {
+			return false
+		}
+	}
+	conds := conditions(u)
+	for _, c := range f.Conditions {
+		if !slices.ContainsFunc(conds, func(oc metav1.Condition) bool {
+			return oc.Type == c.Type && (c.Status == "" || string(oc.Status) == c.Status) && (c.Reason == "" || oc.Reason == c.Reason)
+		}) {
+			return false
+		}
+	}
+	if f.jsonpath != nil {
+		return matchesJSONPath(u, f.jsonpath, f.JSONPath)
+	}
+	return true
+}
+
+// matchesKind returns true if kind is the object's kind or plural resource,
+// ignoring case.
+func matchesKind(gvk schema.GroupVersionKind, kind string) bool {
+	if strings.EqualFold(gvk.Kind, kind) {
+		return true
+	}
+	plural, _ := meta.UnsafeGuessKindToResource(gvk)
+	return strings.EqualFold(plural.Resource, kind)
+}
+
+// matchesCreationTimestamp returns true if the object was created at or after
+// After, and before Before.
+func matchesCreationTimestamp(t metav1.Time, r queryv1alpha2.QueryCreationTimestamp) bool {
+	if !r.After.IsZero() && t.Before(&r.After) {
+		return false
+	}
+	if !r.Before.IsZero() && !t.Before(&r.Before) {
+		return false
+	}
+	return true
+}
+
+func conditions(u *unstructured.Unstructured) []metav1.Condition {
+	raw, ok, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if !ok {
+		return nil
+	}
+	conds := make([]metav1.Condition, 0, len(raw))
+	for _, r := range raw {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		c := metav1.Condition{}
+		c.Type, _, _ = unstructured.NestedString(m, "type")
+		s, _, _ := unstructured.NestedString(m, "status")
+		c.Status = metav1.ConditionStatus(s)
+		c.Reason, _, _ = unstructured.NestedString(m, "reason")
+		conds = append(conds, c)
+	}
+	return conds
+}
+
+// matchesJSONPath returns true if the filter expression selects the object,
+// or if a query starting with $ returns true. Queries returning anything but
+// a single boolean match no objects.
+func matchesJSONPath(u *unstructured.Unstructured, p *jsonpath.Path, expr string) bool {
+	// Round trip through JSON so that numbers are float64s, as expected by
+	// the JSONPath implementation.
+	b, err := json.Marshal(u.Object)
+	if err != nil {
+		return false
+	}
+	var obj interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return false
+	}
+	if !strings.HasPrefix(expr, "$") {
+		return len(p.Select([]interface{}{obj})) > 0
+	}
+	nodes := p.Select(obj)
+	return len(nodes) == 1 && nodes[0] == true
+}
+
+// sortObjects sorts objects by the supplied orders, then by control plane,
+// API group, kind, namespace and name so that results are stable.
+func sortObjects(objs []InMemoryObject, orders []queryv1alpha2.QueryOrder) error {
+	type key struct {
+		dir queryv1alpha2.Direction
+		fn  func(a, b InMemoryObject) int
+	}
+	byString := func(fn func(o InMemoryObject) string) func(a, b InMemoryObject) int {
+		return func(a, b InMemoryObject) int { return cmp.Compare(fn(a), fn(b)) }
+	}
+	var (
+		byCreation = func(a, b InMemoryObject) int {
+			return a.Object.GetCreationTimestamp().Compare(b.Object.GetCreationTimestamp().Time)
+		}
+		byName         = byString(func(o InMemoryObject) string { return o.Object.GetName() })
+		byNamespace    = byString(func(o InMemoryObject) string { return o.Object.GetNamespace() })
+		byAPIGroup     = byString(func(o InMemoryObject) string { return o.Object.GroupVersionKind().Group })
+		byKind         = byString(func(o InMemoryObject) string { return o.Object.GetKind() })
+		byGroup        = byString(func(o InMemoryObject) string { return o.ControlPlane.Namespace })
+		byControlPlane = byString(func(o InMemoryObject) string { return o.ControlPlane.Name })
+	)
+
+	keys := make([]key, 0, len(orders)+6)
+	for i, o := range orders {
+		var set []key
+		for _, k := range []key{
+			{o.CreationTimestamp, byCreation},
+			{o.Name, byName},
+			{o.Namespace, byNamespace},
+			{o.APIGroup, byAPIGroup},
+			{o.Kind, byKind},
+			{o.Group, byGroup},
+			{o.ControlPlane, byControlPlane},
+		} {
+			if k.dir != "" {
+				set = append(set, k)
+			}
+		}
+		if len(set) != 1 || (set[0].dir != queryv1alpha2.Ascending && set[0].dir != queryv1alpha2.Descending) {
+			return errors.Errorf(errFmtInvalidOrder, i, queryv1alpha2.Ascending, queryv1alpha2.Descending)
+		}
+		keys = append(keys, set[0])
+	}
+	for _, fn := range []func(a, b InMemoryObject) int{byGroup, byControlPlane, byAPIGroup, byKind, byNamespace, byName} {
+		keys = append(keys, key{queryv1alpha2.Ascending, fn})
+	}
+
+	slices.SortStableFunc(objs, func(a, b InMemoryObject) int {
+		for _, k := range keys {
+			c := k.fn(a, b)
+			if k.dir == queryv1alpha2.Descending {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+	return nil
+}
+
+// respond returns the parts of the object requested by the query.
+func respond(o InMemoryObject, qo *queryv1alpha2.QueryObjects) queryv1alpha2.QueryResponseObject {
+	res := queryv1alpha2.QueryResponseObject{}
+	if qo.ID {
+		res.ID = objectID(o)
+	}
+	if qo.ControlPlane {
+		cp := o.ControlPlane
+		res.ControlPlane = &cp
+	}
+	if qo.MutablePath {
+		gvr, _ := meta.UnsafeGuessKindToResource(o.Object.GroupVersionKind())
+		res.MutablePath = &queryv1alpha2.QueryResponseMutablePath{GroupVersionResource: metav1.GroupVersionResource(gvr)}
+	}
+	if qo.Object != nil {
+		if m, ok := project(o.Object.Object, qo.Object.Object).(map[string]interface{}); ok {
+			res.Object = &common.JSONObject{Object: m}
+		}
+	}
+	return res
+}
+
+// project returns the parts of v selected by the skeleton. A skeleton of true
+// selects all of v, and an object skeleton selects the named fields of an
+// object. Fields whose type does not match the skeleton are omitted.
+func project(v interface{}, skeleton interface{}) interface{} {
+	switch s := skeleton.(type) {
+	case bool:
+		if s {
+			return runtime.DeepCopyJSONValue(v)
+		}
+	case map[string]interface{}:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		out := map[string]interface{}{}
+		for k, sk := range s {
+			fv, ok := m[k]
+			if !ok {
+				continue
+			}
+			if pv := project(fv, sk); pv != nil {
+				out[k] = pv
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// objectID returns an opaque, stable id for the object.
+func objectID(o InMemoryObject) string {
+	gvk := o.Object.GroupVersionKind()
+	key := strings.Join([]string{o.ControlPlane.Namespace, o.ControlPlane.Name, gvk.Group, gvk.Kind, o.Object.GetNamespace(), o.Object.GetName()}, "/")
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+func encodeCursor(pos int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("pos:%d", pos)))
+}
+
+func decodeCursor(c string) (int, error) {
+	if c == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(c)
+	if err != nil {
+		return 0, errors.Wrap(err, errInvalidCursor)
+	}
+	pos, err := strconv.Atoi(strings.TrimPrefix(string(b), "pos:"))
+	if err != nil || pos < 0 {
+		return 0, errors.New(errInvalidCursor)
+	}
+	return pos, nil
+}
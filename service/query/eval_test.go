@@ -0,0 +1,225 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/upbound/up-sdk-go/apis/common"
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+func newObject(apiVersion, kind, namespace, name string, created time.Time, fields map[string]interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: fields}
+	if u.Object == nil {
+		u.Object = map[string]interface{}{}
+	}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	u.SetCreationTimestamp(metav1.NewTime(created))
+	return u
+}
+
+func testEvaluator() *Evaluator {
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctp1 := queryv1alpha2.QueryResponseControlPlane{Namespace: "default", Name: "ctp1"}
+	ctp2 := queryv1alpha2.QueryResponseControlPlane{Namespace: "other", Name: "ctp2"}
+	return NewEvaluator([]InMemoryObject{
+		{ControlPlane: ctp1, Object: newObject("apps/v1", "Deployment", "web", "frontend", t0, map[string]interface{}{
+			"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "web"}},
+			"spec":     map[string]interface{}{"replicas": int64(3)},
+			"status": map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"type": "Available", "status": "True", "reason": "MinimumReplicasAvailable"},
+			}},
+		})},
+		{ControlPlane: ctp1, Object: newObject("apps/v1", "Deployment", "web", "backend", t0.Add(time.Hour), map[string]interface{}{
+			"spec": map[string]interface{}{"replicas": int64(1)},
+			"status": map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"type": "Available", "status": "False"},
+			}},
+		})},
+		{ControlPlane: ctp1, Object: newObject("v1", "Pod", "web", "frontend-abc", t0.Add(2*time.Hour), map[string]interface{}{
+			"spec": map[string]interface{}{"hostNetwork": true},
+		})},
+		{ControlPlane: ctp2, Object: newObject("s3.aws.upbound.io/v1beta1", "Bucket", "", "bucket", t0.Add(3*time.Hour), nil)},
+	}, WithCategories(schema.GroupKind{Group: "s3.aws.upbound.io", Kind: "Bucket"}, "managed"))
+}
+
+func names(res *queryv1alpha2.QueryResponse) []string {
+	var out []string
+	for _, o := range res.Objects {
+		if o.Object == nil {
+			continue
+		}
+		n, _, _ := unstructured.NestedString(o.Object.Object, "metadata", "name")
+		out = append(out, n)
+	}
+	return out
+}
+
+func TestEvaluate(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	type want struct {
+		names      []string
+		count      *int
+		incomplete bool
+	}
+	four := 4
+	cases := map[string]struct {
+		reason  string
+		builder *Builder
+		want    want
+	}{
+		"All": {
+			reason:  "An empty filter should match all objects, ordered by control plane, API group, kind, namespace and name.",
+			builder: New().Select(".metadata.name").Count(),
+			want:    want{names: []string{"frontend-abc", "backend", "frontend", "bucket"}, count: &four},
+		},
+		"GroupKindCaseInsensitivePlural": {
+			reason:  "Kinds should match case-insensitively and by plural resource.",
+			builder: New().Kind("apps", "deployments").Select(".metadata.name"),
+			want:    want{names: []string{"backend", "frontend"}},
+		},
+		"Or": {
+			reason:  "Objects should match any of the filters.",
+			builder: New().Kind("", "Pod").Or().InCategories("managed").Select(".metadata.name"),
+			want:    want{names: []string{"frontend-abc", "bucket"}},
+		},
+		"LabelsAndConditions": {
+			reason:  "All labels and conditions of a filter should match.",
+			builder: New().WithLabels(map[string]string{"app": "web"}).WithConditionReason("Available", "True", "MinimumReplicasAvailable").Select(".metadata.name"),
+			want:    want{names: []string{"frontend"}},
+		},
+		"CreationTimestamp": {
+			reason:  "Objects should be filtered by creation time.",
+			builder: New().CreatedBetween(t0.Add(time.Hour), t0.Add(3*time.Hour)).Select(".metadata.name"),
+			want:    want{names: []string{"frontend-abc", "backend"}},
+		},
+		"JSONPath": {
+			reason:  "Objects should be filtered by JSONPath filter expressions.",
+			builder: New().JSONPath("@.spec.replicas > 1").Select(".metadata.name"),
+			want:    want{names: []string{"frontend"}},
+		},
+		"JSONPathBoolean": {
+			reason:  "Objects should match JSONPath queries that return true.",
+			builder: New().JSONPath("$.spec.hostNetwork").Select(".metadata.name"),
+			want:    want{names: []string{"frontend-abc"}},
+		},
+		"JSONPathNotBoolean": {
+			reason:  "No objects should match JSONPath queries that return anything but a boolean.",
+			builder: New().JSONPath("$.metadata.name").Select(".metadata.name"),
+			want:    want{},
+		},
+		"ControlPlane": {
+			reason:  "Objects should be filtered by control plane.",
+			builder: New().InControlPlane("other", "").Select(".metadata.name"),
+			want:    want{names: []string{"bucket"}},
+		},
+		"OrderAndLimit": {
+			reason:  "Objects should be ordered and limited.",
+			builder: New().Select(".metadata.name").OrderBy(OrderByCreationTimestamp, queryv1alpha2.Descending).Limit(3).Count(),
+			want:    want{names: []string{"bucket", "frontend-abc", "backend"}, count: &four, incomplete: true},
+		},
+		"Skip": {
+			reason:  "Skipped objects should not be returned, and the response marked incomplete.",
+			builder: New().Kind("apps", "Deployment").Select(".metadata.name").Skip(1),
+			want:    want{names: []string{"frontend"}, incomplete: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			spec, err := tc.builder.Build()
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := testEvaluator().Evaluate(spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := want{names: names(res), count: res.Count, incomplete: res.Incomplete}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nEvaluate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEvaluateProjection(t *testing.T) {
+	spec, err := New().Named("frontend").Select(".spec", ".status.conditions", ".metadata.labels.nope").ReturnControlPlane().ReturnMutablePath().Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := testEvaluator().Evaluate(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []queryv1alpha2.QueryResponseObject{{
+		ControlPlane: &queryv1alpha2.QueryResponseControlPlane{Namespace: "default", Name: "ctp1"},
+		MutablePath: &queryv1alpha2.QueryResponseMutablePath{
+			GroupVersionResource: metav1.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+		},
+		Object: &common.JSONObject{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"labels": map[string]interface{}{}},
+			"spec":     map[string]interface{}{"replicas": int64(3)},
+			"status": map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"type": "Available", "status": "True", "reason": "MinimumReplicasAvailable"},
+			}},
+		}},
+	}}
+	if diff := cmp.Diff(want, res.Objects); diff != "" {
+		t.Errorf("\n%s\nEvaluate(...): -want, +got:\n%s", "Only selected fields should be returned.", diff)
+	}
+}
+
+func TestEvaluatorCursor(t *testing.T) {
+	q, err := New().Select(".metadata.name").Limit(2).Cursor().GroupQuery("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := testEvaluator()
+	var got []string
+	for {
+		res, err := e.Do(context.Background(), q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, names(res)...)
+		if res.Cursor == nil || res.Cursor.Next == "" {
+			break
+		}
+		q.Spec.Page.Cursor = res.Cursor.Next
+	}
+	if diff := cmp.Diff([]string{"frontend-abc", "backend", "frontend"}, got); diff != "" {
+		t.Errorf("\n%s\nDo(...): -want, +got:\n%s", "Cursors should page through the objects in the GroupQuery's group.", diff)
+	}
+}
+
+func TestEvaluateInvalidJSONPath(t *testing.T) {
+	spec := &queryv1alpha2.QuerySpec{QueryTopLevelResources: queryv1alpha2.QueryTopLevelResources{
+		Filter: queryv1alpha2.QueryTopLevelFilter{Objects: []queryv1alpha2.QueryFilter{{JSONPath: "@.spec["}}},
+	}}
+	if _, err := testEvaluator().Evaluate(spec); err == nil {
+		t.Errorf("\n%s\nEvaluate(...): expected error", "Invalid JSONPath expressions should be rejected.")
+	}
+}
@@ -0,0 +1,295 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+const (
+	errFmtUnsupportedOutput = "unsupported output %q"
+	errWriteTable           = "cannot write table"
+)
+
+// An Output is a format tables can be rendered in.
+type Output string
+
+// Supported outputs.
+const (
+	// OutputText renders aligned columns with upper case headers, like
+	// kubectl. Dates are rendered as ages.
+	OutputText Output = "text"
+
+	// OutputCSV renders comma separated values. Dates are rendered in
+	// RFC 3339 format.
+	OutputCSV Output = "csv"
+
+	// OutputJSONLines renders one JSON object per row, keyed by column name.
+	// Values keep the type of their column.
+	OutputJSONLines Output = "jsonl"
+
+	// OutputMarkdown renders a Markdown table. Dates are rendered as ages.
+	OutputMarkdown Output = "markdown"
+)
+
+// none is rendered for missing cells in text output, like kubectl.
+const none = "<none>"
+
+type renderOptions struct {
+	priority  int32
+	now       time.Time
+	noHeaders bool
+}
+
+// A RenderOption modifies how tables are rendered.
+type RenderOption func(*renderOptions)
+
+// WithPriority renders columns up to the supplied priority. By default only
+// columns of priority 0 are rendered, like kubectl. Columns of higher
+// priority are shown by kubectl's wide output.
+func WithPriority(p int32) RenderOption {
+	return func(o *renderOptions) {
+		o.priority = p
+	}
+}
+
+// WithNow sets the time ages are computed relative to. It defaults to the
+// current time.
+func WithNow(t time.Time) RenderOption {
+	return func(o *renderOptions) {
+		o.now = t
+	}
+}
+
+// WithoutHeaders omits the header row. It has no effect on JSON Lines.
+func WithoutHeaders() RenderOption {
+	return func(o *renderOptions) {
+		o.noHeaders = true
+	}
+}
+
+// Render writes the table to w in the supplied output format.
+func (t *Table) Render(w io.Writer, out Output, opts ...RenderOption) error {
+	o := &renderOptions{now: time.Now()}
+	for _, fn := range opts {
+		fn(o)
+	}
+	var cols []int
+	for i, c := range t.Columns {
+		if c.Priority <= o.priority {
+			cols = append(cols, i)
+		}
+	}
+	var err error
+	switch out {
+	case OutputText:
+		err = t.renderText(w, cols, o)
+	case OutputCSV:
+		err = t.renderCSV(w, cols, o)
+	case OutputJSONLines:
+		err = t.renderJSONLines(w, cols)
+	case OutputMarkdown:
+		err = t.renderMarkdown(w, cols, o)
+	default:
+		return errors.Errorf(errFmtUnsupportedOutput, out)
+	}
+	return errors.Wrap(err, errWriteTable)
+}
+
+// RenderAll writes the supplied tables to w in the supplied output format,
+// separated by empty lines unless the format is JSON Lines.
+func RenderAll(w io.Writer, ts []*Table, out Output, opts ...RenderOption) error {
+	for i, t := range ts {
+		if i > 0 && out != OutputJSONLines {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return errors.Wrap(err, errWriteTable)
+			}
+		}
+		if err := t.Render(w, out, opts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *Table) renderText(w io.Writer, cols []int, o *renderOptions) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 3, ' ', 0)
+	if !o.noHeaders {
+		fmt.Fprintln(tw, strings.Join(t.header(cols, strings.ToUpper), "\t"))
+	}
+	for _, r := range t.Rows {
+		fmt.Fprintln(tw, strings.Join(t.cells(r, cols, func(v interface{}, c metav1.TableColumnDefinition) string {
+			return human(v, c, o.now, none)
+		}), "\t"))
+	}
+	return tw.Flush()
+}
+
+func (t *Table) renderMarkdown(w io.Writer, cols []int, o *renderOptions) error {
+	line := func(cells []string) string {
+		for i := range cells {
+			cells[i] = markdownEscaper.Replace(cells[i])
+		}
+		return "| " + strings.Join(cells, " | ") + " |\n"
+	}
+	var b strings.Builder
+	if !o.noHeaders {
+		b.WriteString(line(t.header(cols, nil)))
+		sep := make([]string, len(cols))
+		for i, c := range cols {
+			sep[i] = "---"
+			if isNumeric(t.Columns[c]) {
+				sep[i] = "--:"
+			}
+		}
+		b.WriteString("|" + strings.Join(sep, "|") + "|\n")
+	}
+	for _, r := range t.Rows {
+		b.WriteString(line(t.cells(r, cols, func(v interface{}, c metav1.TableColumnDefinition) string {
+			return human(v, c, o.now, "")
+		})))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+func (t *Table) renderCSV(w io.Writer, cols []int, o *renderOptions) error {
+	cw := csv.NewWriter(w)
+	if !o.noHeaders {
+		if err := cw.Write(t.header(cols, nil)); err != nil {
+			return err
+		}
+	}
+	for _, r := range t.Rows {
+		if err := cw.Write(t.cells(r, cols, exact)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func (t *Table) renderJSONLines(w io.Writer, cols []int) error {
+	enc := json.NewEncoder(w)
+	for _, r := range t.Rows {
+		obj := make(map[string]interface{}, len(cols))
+		for _, i := range cols {
+			c := t.Columns[i]
+			v, ok := r.value(i, c)
+			switch {
+			case ok:
+				obj[c.Name] = v
+			case i < len(r.cells):
+				// Keep cells that don't match their column's type as-is.
+				obj[c.Name] = r.cells[i]
+			default:
+				obj[c.Name] = nil
+			}
+		}
+		if err := enc.Encode(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *Table) header(cols []int, fn func(string) string) []string {
+	h := make([]string, len(cols))
+	for i, c := range cols {
+		h[i] = t.Columns[c].Name
+		if fn != nil {
+			h[i] = fn(h[i])
+		}
+	}
+	return h
+}
+
+func (t *Table) cells(r Row, cols []int, format func(v interface{}, c metav1.TableColumnDefinition) string) []string {
+	out := make([]string, len(cols))
+	for i, c := range cols {
+		var v interface{}
+		if c < len(r.cells) {
+			v = r.cells[c]
+		}
+		out[i] = format(v, t.Columns[c])
+	}
+	return out
+}
+
+// human formats a cell for people to read. Dates are formatted as ages.
+func human(v interface{}, c metav1.TableColumnDefinition, now time.Time, missing string) string {
+	if v == nil {
+		return missing
+	}
+	if tv, ok := convert(v, c); ok {
+		if ts, ok := tv.(time.Time); ok {
+			return duration.HumanDuration(now.Sub(ts))
+		}
+		return format(tv)
+	}
+	return format(v)
+}
+
+// exact formats a cell for machines to read. Dates are formatted in RFC 3339
+// format.
+func exact(v interface{}, c metav1.TableColumnDefinition) string {
+	if v == nil {
+		return ""
+	}
+	if tv, ok := convert(v, c); ok {
+		if ts, ok := tv.(time.Time); ok {
+			return ts.Format(time.RFC3339)
+		}
+		return format(tv)
+	}
+	return format(v)
+}
+
+func format(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+}
+
+func isNumeric(c metav1.TableColumnDefinition) bool {
+	return c.Type == TypeInteger || c.Type == TypeNumber
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRender(t *testing.T) {
+	now := WithNow(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	type args struct {
+		out  Output
+		opts []RenderOption
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   string
+	}{
+		"Text": {
+			reason: "Text should be aligned, omit wide columns, and show dates as ages.",
+			args:   args{out: OutputText, opts: []RenderOption{now}},
+			want: `NAME   READY   REPLICAS   AGE
+cool   true    3          12h
+a|b    false   <none>     60m
+`,
+		},
+		"TextWide": {
+			reason: "Columns up to the requested priority should be rendered.",
+			args:   args{out: OutputText, opts: []RenderOption{now, WithPriority(1), WithoutHeaders()}},
+			want: `cool   true    3        0.5   12h
+a|b    false   <none>   1     60m
+`,
+		},
+		"CSV": {
+			reason: "CSV should show exact values.",
+			args:   args{out: OutputCSV},
+			want: `Name,Ready,Replicas,Age
+cool,true,3,2025-01-01T00:00:00Z
+a|b,false,,2025-01-01T11:00:00Z
+`,
+		},
+		"JSONLines": {
+			reason: "JSON Lines should keep the types of values.",
+			args:   args{out: OutputJSONLines},
+			want: `{"Age":"2025-01-01T00:00:00Z","Name":"cool","Ready":true,"Replicas":3}
+{"Age":"2025-01-01T11:00:00Z","Name":"a|b","Ready":false,"Replicas":null}
+`,
+		},
+		"Markdown": {
+			reason: "Markdown should escape pipes and right align numbers.",
+			args:   args{out: OutputMarkdown, opts: []RenderOption{now}},
+			want: `| Name | Ready | Replicas | Age |
+|---|---|--:|---|
+| cool | true | 3 | 12h |
+| a\|b | false |  | 60m |
+`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b := &strings.Builder{}
+			if err := testTable(t).Render(b, tc.args.out, tc.args.opts...); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, b.String()); diff != "" {
+				t.Errorf("\n%s\nRender(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRenderUnsupported(t *testing.T) {
+	err := testTable(t).Render(&strings.Builder{}, Output("yaml"))
+	if diff := cmp.Diff(`unsupported output "yaml"`, err.Error()); diff != "" {
+		t.Errorf("\n%s\nRender(...): -want, +got:\n%s", "Unsupported outputs should be rejected.", diff)
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package table reads and renders the tables returned by the Spaces query
+// API when a query requests objects in table format.
+package table
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+// Column types, as defined by the OpenAPI types of table columns.
+const (
+	TypeInteger = "integer"
+	TypeNumber  = "number"
+	TypeString  = "string"
+	TypeBoolean = "boolean"
+	TypeDate    = "date"
+)
+
+// FormatDateTime is the format of string columns holding RFC 3339 timestamps.
+const FormatDateTime = "date-time"
+
+// A Table of objects of one kind.
+type Table struct {
+	// GroupVersionKind of the objects in the table.
+	GroupVersionKind schema.GroupVersionKind
+
+	// Columns of the table.
+	Columns []metav1.TableColumnDefinition
+
+	// Rows of the table.
+	Rows []Row
+}
+
+// A Row of a table.
+type Row struct {
+	table *Table
+	cells []interface{}
+
+	// Conditions of the object the row describes, if any.
+	Conditions []metav1.TableRowCondition
+}
+
+// New returns a Table for the supplied query response table.
+func New(rt queryv1alpha2.QueryResponseTable) *Table {
+	t := &Table{
+		GroupVersionKind: schema.GroupVersionKind(rt.GroupVersionKind),
+		Columns:          rt.Columns,
+		Rows:             make([]Row, len(rt.Rows)),
+	}
+	for i, r := range rt.Rows {
+		t.Rows[i] = Row{table: t, cells: r.Cells, Conditions: r.Conditions}
+	}
+	return t
+}
+
+// FromResponse returns the tables of a query response.
+func FromResponse(r *queryv1alpha2.QueryResponse) []*Table {
+	if r == nil {
+		return nil
+	}
+	ts := make([]*Table, len(r.Tables))
+	for i, rt := range r.Tables {
+		ts[i] = New(rt)
+	}
+	return ts
+}
+
+// Column returns the index and definition of the named column.
+func (t *Table) Column(name string) (int, metav1.TableColumnDefinition, bool) {
+	for i, c := range t.Columns {
+		if c.Name == name {
+			return i, c, true
+		}
+	}
+	return -1, metav1.TableColumnDefinition{}, false
+}
+
+// Value returns the value of the named column, converted to the Go type of
+// the column's type: int64 for integers, float64 for numbers, bool for
+// booleans, time.Time for dates and date-time strings, and string for other
+// strings. It returns false if the column does not exist, or the cell is
+// missing or does not match the column's type.
+func (r Row) Value(name string) (interface{}, bool) {
+	i, c, ok := r.table.Column(name)
+	if !ok {
+		return nil, false
+	}
+	return r.value(i, c)
+}
+
+// String returns the value of the named string column.
+func (r Row) String(name string) (string, bool) {
+	v, ok := r.Value(name)
+	s, isString := v.(string)
+	return s, ok && isString
+}
+
+// Int returns the value of the named integer column.
+func (r Row) Int(name string) (int64, bool) {
+	v, ok := r.Value(name)
+	i, isInt := v.(int64)
+	return i, ok && isInt
+}
+
+// Float returns the value of the named number or integer column.
+func (r Row) Float(name string) (float64, bool) {
+	v, ok := r.Value(name)
+	switch v := v.(type) {
+	case float64:
+		return v, ok
+	case int64:
+		return float64(v), ok
+	}
+	return 0, false
+}
+
+// Bool returns the value of the named boolean column.
+func (r Row) Bool(name string) (bool, bool) {
+	v, ok := r.Value(name)
+	b, isBool := v.(bool)
+	return b, ok && isBool
+}
+
+// Time returns the value of the named date or date-time column.
+func (r Row) Time(name string) (time.Time, bool) {
+	v, ok := r.Value(name)
+	t, isTime := v.(time.Time)
+	return t, ok && isTime
+}
+
+// Cells returns the raw cells of the row, in column order.
+func (r Row) Cells() []interface{} {
+	return r.cells
+}
+
+func (r Row) value(i int, c metav1.TableColumnDefinition) (interface{}, bool) {
+	if i >= len(r.cells) || r.cells[i] == nil {
+		return nil, false
+	}
+	return convert(r.cells[i], c)
+}
+
+// convert converts a cell decoded from JSON into the Go type of its column.
+func convert(v interface{}, c metav1.TableColumnDefinition) (interface{}, bool) { //nolint:gocyclo // A switch over each column type.
+	switch c.Type {
+	case TypeInteger:
+		switch v := v.(type) {
+		case int64:
+			return v, true
+		case int:
+			return int64(v), true
+		case float64:
+			if v == float64(int64(v)) {
+				return int64(v), true
+			}
+		case json.Number:
+			i, err := v.Int64()
+			return i, err == nil
+		}
+	case TypeNumber:
+		switch v := v.(type) {
+		case float64:
+			return v, true
+		case int64:
+			return float64(v), true
+		case int:
+			return float64(v), true
+		case json.Number:
+			f, err := v.Float64()
+			return f, err == nil
+		}
+	case TypeBoolean:
+		switch v := v.(type) {
+		case bool:
+			return v, true
+		case string:
+			b, err := strconv.ParseBool(v)
+			return b, err == nil
+		}
+	case TypeDate:
+		return parseTime(v)
+	default:
+		s, ok := v.(string)
+		if !ok {
+			return nil, false
+		}
+		if c.Format == FormatDateTime {
+			return parseTime(s)
+		}
+		return s, true
+	}
+	return nil, false
+}
+
+func parseTime(v interface{}) (interface{}, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, false
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	return t, err == nil
+}
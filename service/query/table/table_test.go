@@ -0,0 +1,103 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	queryv1alpha2 "github.com/upbound/up-sdk-go/apis/query/v1alpha2"
+)
+
+// testTable returns a table as decoded from a JSON query response, so that
+// numbers are float64s.
+func testTable(t *testing.T) *Table {
+	t.Helper()
+	raw := `{
+		"groupVersionKind": {"group": "spaces.upbound.io", "version": "v1beta1", "kind": "ControlPlane"},
+		"columns": [
+			{"name": "Name", "type": "string", "format": "name"},
+			{"name": "Ready", "type": "boolean"},
+			{"name": "Replicas", "type": "integer"},
+			{"name": "Ratio", "type": "number", "priority": 1},
+			{"name": "Age", "type": "string", "format": "date-time"}
+		],
+		"rows": [
+			{"cells": ["cool", true, 3, 0.5, "2025-01-01T00:00:00Z"]},
+			{"cells": ["a|b", false, null, 1, "2025-01-01T11:00:00Z"]}
+		]
+	}`
+	rt := queryv1alpha2.QueryResponseTable{}
+	if err := json.Unmarshal([]byte(raw), &rt); err != nil {
+		t.Fatal(err)
+	}
+	return New(rt)
+}
+
+func TestRowValue(t *testing.T) {
+	tbl := testTable(t)
+	r := tbl.Rows[0]
+
+	type got struct {
+		Name     string
+		Ready    bool
+		Replicas int64
+		Ratio    float64
+		Age      time.Time
+		Missing  bool
+	}
+	g := got{}
+	g.Name, _ = r.String("Name")
+	g.Ready, _ = r.Bool("Ready")
+	g.Replicas, _ = r.Int("Replicas")
+	g.Ratio, _ = r.Float("Ratio")
+	g.Age, _ = r.Time("Age")
+	_, g.Missing = tbl.Rows[1].Int("Replicas")
+
+	want := got{
+		Name:     "cool",
+		Ready:    true,
+		Replicas: 3,
+		Ratio:    0.5,
+		Age:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if diff := cmp.Diff(want, g); diff != "" {
+		t.Errorf("\n%s\nValue(...): -want, +got:\n%s", "Cells should be converted to the Go types of their columns.", diff)
+	}
+	if _, ok := r.Int("Name"); ok {
+		t.Errorf("\n%s\nInt(...): got ok", "Cells should not be converted to a type other than their column's.")
+	}
+	if _, ok := r.Value("Nope"); ok {
+		t.Errorf("\n%s\nValue(...): got ok", "Unknown columns should not have values.")
+	}
+}
+
+func TestFromResponse(t *testing.T) {
+	r := &queryv1alpha2.QueryResponse{QueryResponseObjects: queryv1alpha2.QueryResponseObjects{Tables: []queryv1alpha2.QueryResponseTable{
+		{GroupVersionKind: metav1.GroupVersionKind{Kind: "A"}},
+		{GroupVersionKind: metav1.GroupVersionKind{Kind: "B"}},
+	}}}
+	var kinds []string
+	for _, tbl := range FromResponse(r) {
+		kinds = append(kinds, tbl.GroupVersionKind.Kind)
+	}
+	if diff := cmp.Diff([]string{"A", "B"}, kinds); diff != "" {
+		t.Errorf("\n%s\nFromResponse(...): -want, +got:\n%s", "A table should be returned for each table in the response.", diff)
+	}
+}
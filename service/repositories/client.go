@@ -16,6 +16,7 @@ package repositories
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"path"
 
@@ -131,3 +132,18 @@ func (c *Client) Delete(ctx context.Context, account, name string) error { // no
 	}
 	return c.Client.Do(req, nil)
 }
+
+// ListAll returns an iterator over all repositories in the account. Pages are
+// fetched lazily as the iterator is advanced.
+func (c *Client) ListAll(ctx context.Context, account string, opts ...common.PagerOption) iter.Seq2[Repository, error] {
+	return common.NewPager(func(ctx context.Context, p common.PageRequest) (*common.PageResponse[Repository], error) {
+		res, err := c.List(ctx, account, p.Apply)
+		if err != nil {
+			return nil, err
+		}
+		return &common.PageResponse[Repository]{
+			Items: res.Repositories,
+			Last:  common.IsLastPage(len(res.Repositories), res.Size),
+		}, nil
+	}, opts...).All(ctx)
+}
@@ -17,11 +17,13 @@ package repositorypermission
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/google/uuid"
 
 	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/common"
 )
 
 const (
@@ -59,14 +61,32 @@ func (c *Client) Delete(ctx context.Context, organization string, teamID uuid.UU
 }
 
 // List retrieves all repository permissions assigned to a team on Upbound.
-func (c *Client) List(ctx context.Context, organization string, teamID uuid.UUID) (*ListPermissionsResponse, error) {
+func (c *Client) List(ctx context.Context, organization string, teamID uuid.UUID, opts ...common.ListOption) (*ListPermissionsResponse, error) {
 	req, err := c.Client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(basePath, organization, teamID), "", nil)
 	if err != nil {
 		return nil, err
 	}
+	for _, o := range opts {
+		o(req)
+	}
 	r := &ListPermissionsResponse{}
 	if err := c.Client.Do(req, r); err != nil {
 		return nil, err
 	}
 	return r, nil
 }
+
+// ListAll returns an iterator over all repository permissions assigned to a
+// team on Upbound. Pages are fetched lazily as the iterator is advanced.
+func (c *Client) ListAll(ctx context.Context, organization string, teamID uuid.UUID, opts ...common.PagerOption) iter.Seq2[Permission, error] {
+	return common.NewPager(func(ctx context.Context, p common.PageRequest) (*common.PageResponse[Permission], error) {
+		res, err := c.List(ctx, organization, teamID, p.Apply)
+		if err != nil {
+			return nil, err
+		}
+		return &common.PageResponse[Permission]{
+			Items: res.Permissions,
+			Last:  common.IsLastPage(len(res.Permissions), res.Size),
+		}, nil
+	}, opts...).All(ctx)
+}
@@ -16,12 +16,14 @@ package robots
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"path"
 
 	"github.com/google/uuid"
 
 	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/common"
 	"github.com/upbound/up-sdk-go/service/tokens"
 )
 
@@ -119,3 +121,14 @@ func (c *Client) DeleteTeamMembership(ctx context.Context, id uuid.UUID, params
 	}
 	return c.Client.Do(req, nil)
 }
+
+// ListAllTokens returns an iterator over all tokens for a robot on Upbound.
+func (c *Client) ListAllTokens(ctx context.Context, id uuid.UUID, opts ...common.PagerOption) iter.Seq2[common.DataSet, error] {
+	return common.NewPager(common.SinglePage(func(ctx context.Context) ([]common.DataSet, error) {
+		res, err := c.ListTokens(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return res.DataSet, nil
+	}), opts...).All(ctx)
+}
@@ -17,21 +17,33 @@ package spaces
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
+	"iter"
 	"net/http"
-	"net/url"
 	"path"
+	"reflect"
 
-	"k8s.io/apimachinery/pkg/api/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 
 	"github.com/upbound/up-sdk-go"
 	upboundv1alpha1 "github.com/upbound/up-sdk-go/apis/upbound/v1alpha1"
+	"github.com/upbound/up-sdk-go/service/common"
 )
 
 const (
 	basePath  = "apis/upbound.io/v1alpha1/namespaces"
 	spacePath = "spaces"
+
+	errFmtUnsupportedPatch  = "unsupported patch type %q"
+	errStreamingUnsupported = "client does not support streaming responses"
 )
 
 // NewClient creates a new spaces client.
@@ -48,17 +60,9 @@ type Client struct {
 
 // Create creates a space.
 func (c *Client) Create(ctx context.Context, namespace string, space *upboundv1alpha1.Space, opts *metav1.CreateOptions) (*upboundv1alpha1.Space, error) {
-	var params url.Values
-	if opts != nil {
-		p, err := parameterCodec.EncodeParameters(opts, metav1.SchemeGroupVersion)
-		if err != nil {
-			return nil, err
-		}
-		params = p
-	}
-	urlPath := path.Join(basePath, namespace, spacePath)
-	if len(params) > 0 {
-		urlPath += "?" + params.Encode()
+	urlPath, err := resourcePath(namespace, "", opts)
+	if err != nil {
+		return nil, err
 	}
 	req, err := c.uc.NewRequest(ctx, http.MethodPost, "", urlPath, space)
 	if err != nil {
@@ -72,19 +76,69 @@ func (c *Client) Create(ctx context.Context, namespace string, space *upboundv1a
 	return res, nil
 }
 
+// Get gets a space.
+func (c *Client) Get(ctx context.Context, namespace, name string, opts *metav1.GetOptions) (*upboundv1alpha1.Space, error) {
+	urlPath, err := resourcePath(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := c.uc.NewRequest(ctx, http.MethodGet, "", urlPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := &upboundv1alpha1.Space{}
+	err = c.uc.Do(req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Update updates a space.
+func (c *Client) Update(ctx context.Context, namespace string, space *upboundv1alpha1.Space, opts *metav1.UpdateOptions) (*upboundv1alpha1.Space, error) {
+	urlPath, err := resourcePath(namespace, space.GetName(), opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := c.uc.NewRequest(ctx, http.MethodPut, "", urlPath, space)
+	if err != nil {
+		return nil, err
+	}
+	res := &upboundv1alpha1.Space{}
+	err = c.uc.Do(req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Patch patches a space. Merge patches and JSON patches are supported.
+func (c *Client) Patch(ctx context.Context, namespace, name string, pt types.PatchType, data []byte, opts *metav1.PatchOptions) (*upboundv1alpha1.Space, error) {
+	if pt != types.MergePatchType && pt != types.JSONPatchType {
+		return nil, fmt.Errorf(errFmtUnsupportedPatch, pt)
+	}
+	urlPath, err := resourcePath(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := c.uc.NewRequest(ctx, http.MethodPatch, "", urlPath, json.RawMessage(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", string(pt))
+	res := &upboundv1alpha1.Space{}
+	err = c.uc.Do(req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // List lists all accessible space.
 func (c *Client) List(ctx context.Context, namespace string, opts *metav1.ListOptions) (*upboundv1alpha1.SpaceList, error) {
-	var params url.Values
-	if opts != nil {
-		p, err := parameterCodec.EncodeParameters(opts, metav1.SchemeGroupVersion)
-		if err != nil {
-			return nil, err
-		}
-		params = p
-	}
-	urlPath := path.Join(basePath, namespace, spacePath)
-	if len(params) > 0 {
-		urlPath += "?" + params.Encode()
+	urlPath, err := resourcePath(namespace, "", opts)
+	if err != nil {
+		return nil, err
 	}
 	req, err := c.uc.NewRequest(ctx, http.MethodGet, "", urlPath, nil)
 	if err != nil {
@@ -98,19 +152,63 @@ func (c *Client) List(ctx context.Context, namespace string, opts *metav1.ListOp
 	return res, nil
 }
 
-// Delete deletes a space.
-func (c *Client) Delete(ctx context.Context, namespace, name string, opts *metav1.DeleteOptions) error {
-	var params url.Values
+// Watch watches accessible spaces. The watch is stopped when the context is
+// cancelled or Stop is called on the returned watch.Interface.
+func (c *Client) Watch(ctx context.Context, namespace string, opts *metav1.ListOptions) (watch.Interface, error) {
+	sc, ok := c.uc.(up.StreamingClient)
+	if !ok {
+		return nil, errors.New(errStreamingUnsupported)
+	}
+	o := &metav1.ListOptions{}
 	if opts != nil {
-		p, err := parameterCodec.EncodeParameters(opts, metav1.SchemeGroupVersion)
+		o = opts.DeepCopy()
+	}
+	o.Watch = true
+	urlPath, err := resourcePath(namespace, "", o)
+	if err != nil {
+		return nil, err
+	}
+	req, err := c.uc.NewRequest(ctx, http.MethodGet, "", urlPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := sc.Stream(req)
+	if err != nil {
+		return nil, err
+	}
+	return watch.NewStreamWatcher(newWatchDecoder(body, codec, nil), &watchErrorReporter{}), nil
+}
+
+// ListAll returns an iterator over all accessible spaces. Pages are fetched
+// lazily as the iterator is advanced, using the page size as the list limit
+// and following continuation tokens.
+func (c *Client) ListAll(ctx context.Context, namespace string, opts *metav1.ListOptions, pagerOpts ...common.PagerOption) iter.Seq2[upboundv1alpha1.Space, error] {
+	return common.NewPager(func(ctx context.Context, p common.PageRequest) (*common.PageResponse[upboundv1alpha1.Space], error) {
+		o := &metav1.ListOptions{}
+		if opts != nil {
+			o = opts.DeepCopy()
+		}
+		if p.Size > 0 {
+			o.Limit = int64(p.Size)
+		}
+		o.Continue = p.Continue
+		res, err := c.List(ctx, namespace, o)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		params = p
-	}
-	urlPath := path.Join(basePath, namespace, spacePath, name)
-	if len(params) > 0 {
-		urlPath += "?" + params.Encode()
+		return &common.PageResponse[upboundv1alpha1.Space]{
+			Items:    res.Items,
+			Continue: res.Continue,
+			Last:     res.Continue == "",
+		}, nil
+	}, pagerOpts...).All(ctx)
+}
+
+// Delete deletes a space.
+func (c *Client) Delete(ctx context.Context, namespace, name string, opts *metav1.DeleteOptions) error {
+	urlPath, err := resourcePath(namespace, name, opts)
+	if err != nil {
+		return err
 	}
 	req, err := c.uc.NewRequest(ctx, http.MethodDelete, "", urlPath, nil)
 	if err != nil {
@@ -119,6 +217,27 @@ func (c *Client) Delete(ctx context.Context, namespace, name string, opts *metav
 	return c.uc.Do(req, nil)
 }
 
+// resourcePath returns the path of the spaces in a namespace, or of a single
+// space if name is not empty, with any options encoded as query parameters.
+func resourcePath(namespace, name string, opts runtime.Object) (string, error) {
+	return withOptions(path.Join(basePath, namespace, spacePath, name), opts)
+}
+
+// withOptions encodes any options as query parameters of the supplied path.
+func withOptions(urlPath string, opts runtime.Object) (string, error) {
+	if opts == nil || reflect.ValueOf(opts).IsNil() {
+		return urlPath, nil
+	}
+	params, err := parameterCodec.EncodeParameters(opts, metav1.SchemeGroupVersion)
+	if err != nil {
+		return "", err
+	}
+	if len(params) > 0 {
+		urlPath += "?" + params.Encode()
+	}
+	return urlPath, nil
+}
+
 var _ up.ResponseErrorHandler = (*kubeErrorHandler)(nil)
 
 type kubeErrorHandler struct{}
@@ -138,7 +257,7 @@ func (k *kubeErrorHandler) Handle(res *http.Response) error {
 	}
 	// any status besides StatusSuccess is considered an error.
 	if st, ok := out.(*metav1.Status); ok && st.Status != metav1.StatusSuccess {
-		return errors.FromObject(st)
+		return apierrors.FromObject(st)
 	}
 	// reset response body for response reader.
 	res.Body = io.NopCloser(bytes.NewBuffer(b))
@@ -15,11 +15,16 @@
 package spaces
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -27,11 +32,14 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 
 	"github.com/upbound/up-sdk-go"
 	upboundv1alpha1 "github.com/upbound/up-sdk-go/apis/upbound/v1alpha1"
+	"github.com/upbound/up-sdk-go/service/common"
 )
 
 func TestClient_Create(t *testing.T) {
@@ -99,6 +107,31 @@ func TestClient_Create(t *testing.T) {
 				},
 			},
 		},
+		"Options": {
+			reason: "encodes the create options as query parameters",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.Query().Get("dryRun"); got != metav1.DryRunAll {
+					t.Errorf("unexpected dryRun parameter: %s", got)
+				}
+				writeObject(t, &upboundv1alpha1.Space{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "space-aaaa",
+					},
+				}, w)
+			},
+			args: args{
+				namespace: "test-org",
+				space:     &upboundv1alpha1.Space{},
+				opts:      &metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}},
+			},
+			want: want{
+				space: &upboundv1alpha1.Space{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "space-aaaa",
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -112,7 +145,7 @@ func TestClient_Create(t *testing.T) {
 				})
 			}))
 			got, err := c.Create(ctx, tc.args.namespace, tc.args.space, tc.args.opts)
-			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+			if diff := cmp.Diff(tc.want.err, statusError(err), test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
 			}
 			if diff := cmp.Diff(tc.want.space, got, cmpopts.IgnoreTypes(metav1.TypeMeta{})); diff != "" {
@@ -187,13 +220,341 @@ func TestClient_Delete(t *testing.T) {
 				})
 			}))
 			err := c.Delete(ctx, tc.args.namespace, tc.args.name, tc.args.opts)
-			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+			if diff := cmp.Diff(tc.want.err, statusError(err), test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
 			}
 		})
 	}
 }
 
+func TestClient_Get(t *testing.T) {
+	type args struct {
+		namespace string
+		name      string
+		opts      *metav1.GetOptions
+	}
+	type want struct {
+		space *upboundv1alpha1.Space
+		err   error
+	}
+	tests := map[string]struct {
+		reason  string
+		handler http.HandlerFunc
+		args    args
+		want    want
+	}{
+		"NotFound": {
+			reason: "returns a not found error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				writeObject(t, &metav1.Status{
+					Status: metav1.StatusFailure,
+					Code:   http.StatusNotFound,
+					Reason: metav1.StatusReasonNotFound,
+				}, w)
+			},
+			args: args{
+				namespace: "test-org",
+				name:      "space-aaaa",
+			},
+			want: want{
+				err: &errors.StatusError{
+					ErrStatus: metav1.Status{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "Status",
+							APIVersion: "v1",
+						},
+						Status: metav1.StatusFailure,
+						Code:   http.StatusNotFound,
+						Reason: metav1.StatusReasonNotFound,
+					},
+				},
+			},
+		},
+		"Success": {
+			reason: "returns the space",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/apis/upbound.io/v1alpha1/namespaces/test-org/spaces/space-aaaa" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if r.URL.Query().Get("resourceVersion") != "1" {
+					t.Errorf("unexpected resourceVersion: %s", r.URL.Query().Get("resourceVersion"))
+				}
+				writeObject(t, &upboundv1alpha1.Space{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "space-aaaa",
+					},
+				}, w)
+			},
+			args: args{
+				namespace: "test-org",
+				name:      "space-aaaa",
+				opts:      &metav1.GetOptions{ResourceVersion: "1"},
+			},
+			want: want{
+				space: &upboundv1alpha1.Space{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "space-aaaa",
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := httptest.NewServer(tc.handler)
+			defer s.Close()
+			c := NewClient(up.NewConfig(func(cfg *up.Config) {
+				cfg.Client = up.NewClient(func(u *up.HTTPClient) {
+					u.BaseURL = parseURL(t, s.URL)
+					u.HTTP = s.Client()
+				})
+			}))
+			got, err := c.Get(ctx, tc.args.namespace, tc.args.name, tc.args.opts)
+			if diff := cmp.Diff(tc.want.err, statusError(err), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.space, got, cmpopts.IgnoreTypes(metav1.TypeMeta{})); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestClient_Update(t *testing.T) {
+	ctx := context.Background()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/apis/upbound.io/v1alpha1/namespaces/test-org/spaces/space-aaaa" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		b, _ := io.ReadAll(r.Body)
+		obj, _, err := codec.Decode(b, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sp := obj.(*upboundv1alpha1.Space)
+		sp.SetResourceVersion("2")
+		writeObject(t, sp, w)
+	}))
+	defer s.Close()
+	c := NewClient(up.NewConfig(func(cfg *up.Config) {
+		cfg.Client = up.NewClient(func(u *up.HTTPClient) {
+			u.BaseURL = parseURL(t, s.URL)
+			u.HTTP = s.Client()
+		})
+	}))
+	in := &upboundv1alpha1.Space{
+		TypeMeta:   metav1.TypeMeta{APIVersion: upboundv1alpha1.SchemeGroupVersion.String(), Kind: upboundv1alpha1.SpaceKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "space-aaaa", ResourceVersion: "1"},
+	}
+	got, err := c.Update(ctx, "test-org", in, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("2", got.GetResourceVersion()); diff != "" {
+		t.Errorf("\n%s\nUpdate(...): -want, +got:\n%s", "returns the updated space", diff)
+	}
+}
+
+func TestClient_Patch(t *testing.T) {
+	type args struct {
+		pt   types.PatchType
+		data []byte
+	}
+	type want struct {
+		labels map[string]string
+		err    error
+	}
+	tests := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"MergePatch": {
+			reason: "sends a merge patch",
+			args: args{
+				pt:   types.MergePatchType,
+				data: []byte(`{"metadata":{"labels":{"cool":"true"}}}`),
+			},
+			want: want{labels: map[string]string{"cool": "true"}},
+		},
+		"JSONPatch": {
+			reason: "sends a JSON patch",
+			args: args{
+				pt:   types.JSONPatchType,
+				data: []byte(`[{"op":"add","path":"/metadata/labels","value":{"cool":"true"}}]`),
+			},
+			want: want{labels: map[string]string{"cool": "true"}},
+		},
+		"UnsupportedPatch": {
+			reason: "rejects unsupported patch types",
+			args: args{
+				pt: types.StrategicMergePatchType,
+			},
+			want: want{err: fmt.Errorf(errFmtUnsupportedPatch, types.StrategicMergePatchType)},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					t.Errorf("unexpected method: %s", r.Method)
+				}
+				if r.Header.Get("Content-Type") != string(tc.args.pt) {
+					t.Errorf("unexpected content type: %s", r.Header.Get("Content-Type"))
+				}
+				b, _ := io.ReadAll(r.Body)
+				if !strings.Contains(string(b), `"cool":"true"`) {
+					t.Errorf("unexpected patch: %s", b)
+				}
+				writeObject(t, &upboundv1alpha1.Space{
+					ObjectMeta: metav1.ObjectMeta{Name: "space-aaaa", Labels: map[string]string{"cool": "true"}},
+				}, w)
+			}))
+			defer s.Close()
+			c := NewClient(up.NewConfig(func(cfg *up.Config) {
+				cfg.Client = up.NewClient(func(u *up.HTTPClient) {
+					u.BaseURL = parseURL(t, s.URL)
+					u.HTTP = s.Client()
+				})
+			}))
+			got, err := c.Patch(ctx, "test-org", "space-aaaa", tc.args.pt, tc.args.data, nil)
+			if diff := cmp.Diff(tc.want.err, statusError(err), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nPatch(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			var labels map[string]string
+			if got != nil {
+				labels = got.GetLabels()
+			}
+			if diff := cmp.Diff(tc.want.labels, labels); diff != "" {
+				t.Errorf("\n%s\nPatch(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestClient_Watch(t *testing.T) {
+	ctx := context.Background()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("watch") != "true" {
+			t.Errorf("unexpected watch parameter: %s", r.URL.Query().Get("watch"))
+		}
+		for _, e := range []struct {
+			t     watch.EventType
+			space *upboundv1alpha1.Space
+		}{
+			{t: watch.Added, space: &upboundv1alpha1.Space{ObjectMeta: metav1.ObjectMeta{Name: "space-aaaa"}}},
+			{t: watch.Modified, space: &upboundv1alpha1.Space{
+				ObjectMeta: metav1.ObjectMeta{Name: "space-aaaa"},
+				Status:     upboundv1alpha1.SpaceStatus{FQDN: "cool.upbound.io"},
+			}},
+		} {
+			buf := &bytes.Buffer{}
+			writeObject(t, e.space, buf)
+			if err := json.NewEncoder(w).Encode(&metav1.WatchEvent{Type: string(e.t), Object: runtime.RawExtension{Raw: buf.Bytes()}}); err != nil {
+				t.Fatal(err)
+			}
+			w.(http.Flusher).Flush()
+		}
+	}))
+	defer s.Close()
+	c := NewClient(up.NewConfig(func(cfg *up.Config) {
+		cfg.Client = up.NewClient(func(u *up.HTTPClient) {
+			u.BaseURL = parseURL(t, s.URL)
+			u.HTTP = s.Client()
+		})
+	}))
+	w, err := c.Watch(ctx, "test-org", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+
+	type event struct {
+		Type watch.EventType
+		Name string
+		FQDN string
+	}
+	var got []event
+	for e := range w.ResultChan() {
+		sp := e.Object.(*upboundv1alpha1.Space)
+		got = append(got, event{Type: e.Type, Name: sp.GetName(), FQDN: sp.Status.FQDN})
+	}
+	want := []event{
+		{Type: watch.Added, Name: "space-aaaa"},
+		{Type: watch.Modified, Name: "space-aaaa", FQDN: "cool.upbound.io"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\n%s\nWatch(...): -want, +got:\n%s", "returns the decoded watch events", diff)
+	}
+}
+
+func TestClient_ListAll(t *testing.T) {
+	type want struct {
+		names     []string
+		continues []string
+		limits    []string
+	}
+	tests := map[string]struct {
+		reason string
+		opts   []common.PagerOption
+		want   want
+	}{
+		"FollowContinue": {
+			reason: "follows continuation tokens until the last page",
+			opts:   []common.PagerOption{common.WithPageSize(1)},
+			want: want{
+				names:     []string{"space-a", "space-b"},
+				continues: []string{"", "next"},
+				limits:    []string{"1", "1"},
+			},
+		},
+		"MaxItems": {
+			reason: "stops fetching pages once the maximum number of items is returned",
+			opts:   []common.PagerOption{common.WithPageSize(1), common.WithMaxItems(1)},
+			want: want{
+				names:     []string{"space-a"},
+				continues: []string{""},
+				limits:    []string{"1"},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				cont := r.URL.Query().Get("continue")
+				got.continues = append(got.continues, cont)
+				got.limits = append(got.limits, r.URL.Query().Get("limit"))
+				l := &upboundv1alpha1.SpaceList{Items: []upboundv1alpha1.Space{{ObjectMeta: metav1.ObjectMeta{Name: "space-a"}}}}
+				l.Continue = "next"
+				if cont == "next" {
+					l = &upboundv1alpha1.SpaceList{Items: []upboundv1alpha1.Space{{ObjectMeta: metav1.ObjectMeta{Name: "space-b"}}}}
+				}
+				writeObject(t, l, w)
+			}))
+			defer s.Close()
+			c := NewClient(up.NewConfig(func(cfg *up.Config) {
+				cfg.Client = up.NewClient(func(u *up.HTTPClient) {
+					u.BaseURL = parseURL(t, s.URL)
+					u.HTTP = s.Client()
+				})
+			}))
+			for sp, err := range c.ListAll(context.Background(), "test-org", nil, tc.opts...) {
+				if err != nil {
+					t.Fatal(err)
+				}
+				got.names = append(got.names, sp.GetName())
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nListAll(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func writeObject(t *testing.T, obj runtime.Object, w io.Writer) {
 	t.Helper()
 	if err := codec.Encode(obj, w); err != nil {
@@ -209,3 +570,13 @@ func parseURL(t *testing.T, u string) *url.URL {
 	}
 	return url
 }
+
+// statusError returns the Kubernetes status error wrapped by err, if any, so
+// that it can be compared regardless of the request recorded with it.
+func statusError(err error) error {
+	var s *errors.StatusError
+	if stderrors.As(err, &s) {
+		return s
+	}
+	return err
+}
@@ -26,9 +26,12 @@ import (
 )
 
 var (
-	scheme         = runtime.NewScheme()
-	codecs         = serializer.NewCodecFactory(scheme)
-	parameterCodec = runtime.NewParameterCodec(scheme)
+	scheme = runtime.NewScheme()
+	codecs = serializer.NewCodecFactory(scheme)
+	// The options types are only registered under the legacy unversioned
+	// group by metav1.AddToGroupVersion, so encoding them for
+	// meta.k8s.io/v1 requires the metav1 parameter codec.
+	parameterCodec = metav1.ParameterCodec
 	jsonSerializer = json.NewSerializer(json.DefaultMetaFactory, scheme, scheme, false)
 	codec          = codecs.CodecForVersions(jsonSerializer, jsonSerializer, upboundv1alpha1.SchemeGroupVersion, upboundv1alpha1.SchemeGroupVersion)
 )
@@ -0,0 +1,319 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubeconfig generates kubeconfigs for control planes in Spaces.
+package kubeconfig
+
+import (
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	upboundv1alpha1 "github.com/upbound/up-sdk-go/apis/upbound/v1alpha1"
+)
+
+const (
+	// SecretKubeconfigKey is the key in the connection secret of a
+	// ControlPlane that contains the kubeconfig to be used from outside the
+	// Space.
+	SecretKubeconfigKey = "kubeconfig"
+
+	// ExecAPIVersion is the API version of exec credentials.
+	ExecAPIVersion = "client.authentication.k8s.io/v1"
+
+	errIncompleteControlPlane = "space, group and name of the control plane must be set"
+	errNoSecret               = "connection secret must not be nil"
+	errFmtNoSecretKey         = "connection secret %s/%s has no key %q"
+	errParseSecret            = "cannot parse kubeconfig of connection secret"
+	errFmtNoSecretContext     = "kubeconfig of connection secret has no context %q"
+	errFmtNoSecretCluster     = "kubeconfig of connection secret has no cluster %q of context %q"
+	errNoSpace                = "space must not be nil"
+	errFmtNoAPIURL            = "space %s/%s has no API URL"
+	errParseAPIURL            = "cannot parse API URL of space"
+	errNoAuth                 = "either a token or an exec credential is required"
+	errLoadKubeconfig         = "cannot load kubeconfig"
+	errWriteKubeconfig        = "cannot write kubeconfig"
+)
+
+// A ControlPlane identifies a control plane in a Space.
+type ControlPlane struct {
+	// Space is the name of the Space.
+	Space string
+
+	// Group is the group, i.e. namespace, of the control plane.
+	Group string
+
+	// Name is the name of the control plane.
+	Name string
+}
+
+// String returns the control plane as <space>/<group>/<controlplane>. It is
+// used as the name of the generated cluster, user and context.
+func (cp ControlPlane) String() string {
+	return path.Join(cp.Space, cp.Group, cp.Name)
+}
+
+func (cp ControlPlane) validate() error {
+	if cp.Space == "" || cp.Group == "" || cp.Name == "" {
+		return errors.New(errIncompleteControlPlane)
+	}
+	return nil
+}
+
+type options struct {
+	token    string
+	exec     *clientcmdapi.ExecConfig
+	ca       []byte
+	insecure bool
+	key      string
+	context  string
+}
+
+// An Option modifies how a kubeconfig is generated.
+type Option func(*options)
+
+// WithToken authenticates to the control plane with the supplied static
+// token, e.g. an organization scoped token.
+func WithToken(token string) Option {
+	return func(o *options) {
+		o.token = token
+		o.exec = nil
+	}
+}
+
+// WithExecCredential authenticates to the control plane with a token returned
+// by the supplied exec credential plugin, e.g. a command printing an
+// organization scoped token. The API version defaults to ExecAPIVersion and
+// the interactive mode to IfAvailable.
+func WithExecCredential(exec clientcmdapi.ExecConfig) Option {
+	return func(o *options) {
+		if exec.APIVersion == "" {
+			exec.APIVersion = ExecAPIVersion
+		}
+		if exec.InteractiveMode == "" {
+			exec.InteractiveMode = clientcmdapi.IfAvailableExecInteractiveMode
+		}
+		o.exec = &exec
+		o.token = ""
+	}
+}
+
+// WithCertificateAuthority sets the PEM encoded certificate authority used to
+// verify the Space's ingress.
+func WithCertificateAuthority(ca []byte) Option {
+	return func(o *options) {
+		o.ca = ca
+	}
+}
+
+// WithInsecureSkipTLSVerify skips verification of the Space's ingress
+// certificate.
+func WithInsecureSkipTLSVerify() Option {
+	return func(o *options) {
+		o.insecure = true
+	}
+}
+
+// WithInCluster uses the kubeconfig of the connection secret that is meant
+// for pods running in the Space's cluster.
+func WithInCluster() Option {
+	return func(o *options) {
+		o.key = spacesv1beta1.ResourceCredentialsSecretInClusterKubeconfigKey
+	}
+}
+
+// WithContext selects the context of the connection secret's kubeconfig. It
+// defaults to the current context.
+func WithContext(name string) Option {
+	return func(o *options) {
+		o.context = name
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{key: SecretKubeconfigKey}
+	for _, fn := range opts {
+		fn(o)
+	}
+	return o
+}
+
+// FromSecret generates a kubeconfig for the control plane from its connection
+// secret, i.e. the secret referenced by the ControlPlane's
+// WriteConnectionSecretToReference. The credentials of the secret are used
+// unless a token or exec credential is supplied.
+func FromSecret(cp ControlPlane, s *corev1.Secret, opts ...Option) (*clientcmdapi.Config, error) {
+	if err := cp.validate(); err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, errors.New(errNoSecret)
+	}
+	o := newOptions(opts)
+	data, ok := s.Data[o.key]
+	if !ok {
+		return nil, errors.Errorf(errFmtNoSecretKey, s.GetNamespace(), s.GetName(), o.key)
+	}
+	in, err := clientcmd.Load(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseSecret)
+	}
+	name := o.context
+	if name == "" {
+		name = in.CurrentContext
+	}
+	ctx, ok := in.Contexts[name]
+	if !ok {
+		return nil, errors.Errorf(errFmtNoSecretContext, name)
+	}
+	c, ok := in.Clusters[ctx.Cluster]
+	if !ok {
+		return nil, errors.Errorf(errFmtNoSecretCluster, ctx.Cluster, name)
+	}
+	cluster := c.DeepCopy()
+	cluster.LocationOfOrigin = ""
+	if o.ca != nil {
+		cluster.CertificateAuthority = ""
+		cluster.CertificateAuthorityData = o.ca
+	}
+	if o.insecure {
+		cluster.InsecureSkipTLSVerify = true
+		cluster.CertificateAuthority = ""
+		cluster.CertificateAuthorityData = nil
+	}
+	user := clientcmdapi.NewAuthInfo()
+	if u, ok := in.AuthInfos[ctx.AuthInfo]; ok {
+		user = u.DeepCopy()
+		user.LocationOfOrigin = ""
+	}
+	if o.token != "" || o.exec != nil {
+		user = authInfo(o)
+	}
+	return newConfig(cp, cluster, user, ctx.Namespace), nil
+}
+
+// FromSpace generates a kubeconfig for the control plane that connects
+// through the API of the Space, authenticating with the supplied token or
+// exec credential.
+func FromSpace(cp ControlPlane, space *upboundv1alpha1.Space, opts ...Option) (*clientcmdapi.Config, error) {
+	if err := cp.validate(); err != nil {
+		return nil, err
+	}
+	if space == nil {
+		return nil, errors.New(errNoSpace)
+	}
+	if space.Status.APIURL == "" {
+		return nil, errors.Errorf(errFmtNoAPIURL, space.GetNamespace(), space.GetName())
+	}
+	return FromAPIURL(cp, space.Status.APIURL, opts...)
+}
+
+// FromAPIURL generates a kubeconfig for the control plane that connects
+// through the supplied Space API URL, authenticating with the supplied token
+// or exec credential.
+func FromAPIURL(cp ControlPlane, apiURL string, opts ...Option) (*clientcmdapi.Config, error) {
+	if err := cp.validate(); err != nil {
+		return nil, err
+	}
+	o := newOptions(opts)
+	if o.token == "" && o.exec == nil {
+		return nil, errors.New(errNoAuth)
+	}
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseAPIURL)
+	}
+	u = u.JoinPath(ServerPath(cp.Group, cp.Name))
+
+	cluster := clientcmdapi.NewCluster()
+	cluster.Server = u.String()
+	cluster.CertificateAuthorityData = o.ca
+	cluster.InsecureSkipTLSVerify = o.insecure
+	if o.insecure {
+		cluster.CertificateAuthorityData = nil
+	}
+	return newConfig(cp, cluster, authInfo(o), ""), nil
+}
+
+// ServerPath returns the path of the Kubernetes API of a control plane,
+// relative to the Space API URL.
+func ServerPath(group, name string) string {
+	return strings.Join([]string{
+		"apis", spacesv1beta1.Group, spacesv1beta1.Version,
+		"namespaces", group, "controlplanes", name, "k8s",
+	}, "/")
+}
+
+func authInfo(o *options) *clientcmdapi.AuthInfo {
+	user := clientcmdapi.NewAuthInfo()
+	user.Token = o.token
+	if o.exec != nil {
+		user.Exec = o.exec.DeepCopy()
+	}
+	return user
+}
+
+func newConfig(cp ControlPlane, cluster *clientcmdapi.Cluster, user *clientcmdapi.AuthInfo, namespace string) *clientcmdapi.Config {
+	name := cp.String()
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[name] = cluster
+	cfg.AuthInfos[name] = user
+	ctx := clientcmdapi.NewContext()
+	ctx.Cluster = name
+	ctx.AuthInfo = name
+	ctx.Namespace = namespace
+	cfg.Contexts[name] = ctx
+	cfg.CurrentContext = name
+	return cfg
+}
+
+// Merge merges the clusters, users and contexts of src into dst, replacing
+// entries of the same name. The current context of dst is set to the one of
+// src, if any.
+func Merge(dst, src *clientcmdapi.Config) {
+	for k, v := range src.Clusters {
+		dst.Clusters[k] = v.DeepCopy()
+	}
+	for k, v := range src.AuthInfos {
+		dst.AuthInfos[k] = v.DeepCopy()
+	}
+	for k, v := range src.Contexts {
+		dst.Contexts[k] = v.DeepCopy()
+	}
+	if src.CurrentContext != "" {
+		dst.CurrentContext = src.CurrentContext
+	}
+}
+
+// MergeFile merges src into the kubeconfig file at the supplied path, which
+// is created if it does not exist.
+func MergeFile(filename string, src *clientcmdapi.Config) error {
+	dst, err := clientcmd.LoadFromFile(filename)
+	switch {
+	case os.IsNotExist(err):
+		dst = clientcmdapi.NewConfig()
+	case err != nil:
+		return errors.Wrap(err, errLoadKubeconfig)
+	}
+	Merge(dst, src)
+	return errors.Wrap(clientcmd.WriteToFile(*dst, filename), errWriteKubeconfig)
+}
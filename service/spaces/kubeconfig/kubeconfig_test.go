@@ -0,0 +1,268 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeconfig
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+
+	upboundv1alpha1 "github.com/upbound/up-sdk-go/apis/upbound/v1alpha1"
+)
+
+var cp = ControlPlane{Space: "upbound-gcp-us-west-1", Group: "default", Name: "ctp1"}
+
+const secretKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: ctp1
+  cluster:
+    server: https://ctp1.example.com
+    certificate-authority-data: Q0E=
+- name: in-cluster
+  cluster:
+    server: https://kube-apiserver.ctp1.svc
+users:
+- name: ctp1
+  user:
+    token: secret-token
+contexts:
+- name: ctp1
+  context:
+    cluster: ctp1
+    user: ctp1
+    namespace: crossplane-system
+- name: in-cluster
+  context:
+    cluster: in-cluster
+    user: ctp1
+- name: dangling
+  context:
+    cluster: nope
+    user: ctp1
+current-context: ctp1
+`
+
+// config builds the expected kubeconfig for cp.
+func config(cluster *clientcmdapi.Cluster, user *clientcmdapi.AuthInfo, namespace string) *clientcmdapi.Config {
+	name := cp.String()
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[name] = cluster
+	cfg.AuthInfos[name] = user
+	cfg.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name, Namespace: namespace}
+	cfg.CurrentContext = name
+	return cfg
+}
+
+func TestFromSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "kubeconfig-ctp1"},
+		Data: map[string][]byte{
+			SecretKubeconfigKey:     []byte(secretKubeconfig),
+			"kubeconfig-incluster":  []byte(secretKubeconfig),
+			"unrelated-connections": []byte("nope"),
+		},
+	}
+	type args struct {
+		s    *corev1.Secret
+		opts []Option
+	}
+	type want struct {
+		cfg *clientcmdapi.Config
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"CurrentContext": {
+			reason: "The current context of the secret's kubeconfig should be renamed after the control plane.",
+			args:   args{s: secret},
+			want: want{cfg: config(
+				&clientcmdapi.Cluster{Server: "https://ctp1.example.com", CertificateAuthorityData: []byte("CA")},
+				&clientcmdapi.AuthInfo{Token: "secret-token"},
+				"crossplane-system",
+			)},
+		},
+		"InClusterContext": {
+			reason: "The in-cluster kubeconfig and the selected context should be used.",
+			args:   args{s: secret, opts: []Option{WithInCluster(), WithContext("in-cluster")}},
+			want: want{cfg: config(
+				&clientcmdapi.Cluster{Server: "https://kube-apiserver.ctp1.svc"},
+				&clientcmdapi.AuthInfo{Token: "secret-token"},
+				"",
+			)},
+		},
+		"ExecCredential": {
+			reason: "An exec credential should replace the credentials of the secret.",
+			args: args{s: secret, opts: []Option{
+				WithExecCredential(clientcmdapi.ExecConfig{Command: "up", Args: []string{"org", "token"}}),
+				WithInsecureSkipTLSVerify(),
+			}},
+			want: want{cfg: config(
+				&clientcmdapi.Cluster{Server: "https://ctp1.example.com", InsecureSkipTLSVerify: true},
+				&clientcmdapi.AuthInfo{Exec: &clientcmdapi.ExecConfig{
+					Command:         "up",
+					Args:            []string{"org", "token"},
+					APIVersion:      ExecAPIVersion,
+					InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+				}},
+				"crossplane-system",
+			)},
+		},
+		"MissingContext": {
+			reason: "A missing context should return an error.",
+			args:   args{s: secret, opts: []Option{WithContext("nope")}},
+			want:   want{err: errors.Errorf(errFmtNoSecretContext, "nope")},
+		},
+		"MissingCluster": {
+			reason: "A context whose cluster is missing should return an error.",
+			args:   args{s: secret, opts: []Option{WithContext("dangling")}},
+			want:   want{err: errors.Errorf(errFmtNoSecretCluster, "nope", "dangling")},
+		},
+		"MissingKey": {
+			reason: "A secret without a kubeconfig should return an error.",
+			args:   args{s: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "empty"}}},
+			want:   want{err: errors.Errorf(errFmtNoSecretKey, "default", "empty", SecretKubeconfigKey)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := FromSecret(cp, tc.args.s, tc.args.opts...)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nFromSecret(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cfg, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nFromSecret(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFromSpace(t *testing.T) {
+	space := &upboundv1alpha1.Space{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "upbound", Name: "upbound-gcp-us-west-1"},
+		Status:     upboundv1alpha1.SpaceStatus{APIURL: "https://us-west-1.gcp.example.com/"},
+	}
+	server := "https://us-west-1.gcp.example.com/apis/spaces.upbound.io/v1beta1/namespaces/default/controlplanes/ctp1/k8s"
+
+	type args struct {
+		cp    ControlPlane
+		space *upboundv1alpha1.Space
+		opts  []Option
+	}
+	type want struct {
+		cfg *clientcmdapi.Config
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Token": {
+			reason: "A static token should authenticate against the control plane's path of the Space API.",
+			args:   args{cp: cp, space: space, opts: []Option{WithToken("org-token"), WithCertificateAuthority([]byte("CA"))}},
+			want: want{cfg: config(
+				&clientcmdapi.Cluster{Server: server, CertificateAuthorityData: []byte("CA")},
+				&clientcmdapi.AuthInfo{Token: "org-token"},
+				"",
+			)},
+		},
+		"NoAuth": {
+			reason: "A token or exec credential should be required.",
+			args:   args{cp: cp, space: space},
+			want:   want{err: errors.New(errNoAuth)},
+		},
+		"NoAPIURL": {
+			reason: "A Space without an API URL should return an error.",
+			args:   args{cp: cp, space: &upboundv1alpha1.Space{ObjectMeta: space.ObjectMeta}, opts: []Option{WithToken("org-token")}},
+			want:   want{err: errors.Errorf(errFmtNoAPIURL, "upbound", "upbound-gcp-us-west-1")},
+		},
+		"IncompleteControlPlane": {
+			reason: "The space, group and name of the control plane should be required.",
+			args:   args{cp: ControlPlane{Name: "ctp1"}, space: space, opts: []Option{WithToken("org-token")}},
+			want:   want{err: errors.New(errIncompleteControlPlane)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := FromSpace(tc.args.cp, tc.args.space, tc.args.opts...)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nFromSpace(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cfg, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nFromSpace(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMergeFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "config")
+
+	existing := clientcmdapi.NewConfig()
+	existing.Clusters["kind"] = &clientcmdapi.Cluster{Server: "https://127.0.0.1:6443"}
+	existing.AuthInfos["kind"] = &clientcmdapi.AuthInfo{Token: "kind"}
+	existing.Contexts["kind"] = &clientcmdapi.Context{Cluster: "kind", AuthInfo: "kind"}
+	existing.Contexts[cp.String()] = &clientcmdapi.Context{Cluster: "stale", AuthInfo: "stale"}
+	existing.CurrentContext = "kind"
+	if err := clientcmd.WriteToFile(*existing, filename); err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := FromAPIURL(cp, "https://spaces.example.com", WithToken("org-token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := MergeFile(filename, src); err != nil {
+		t.Fatal(err)
+	}
+	got, err := clientcmd.LoadFromFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type summary struct {
+		Clusters       []string
+		Contexts       map[string]string
+		CurrentContext string
+	}
+	want := summary{
+		Clusters:       []string{"kind", cp.String()},
+		Contexts:       map[string]string{"kind": "kind", cp.String(): cp.String()},
+		CurrentContext: cp.String(),
+	}
+	s := summary{Contexts: map[string]string{}, CurrentContext: got.CurrentContext}
+	for name := range got.Clusters {
+		s.Clusters = append(s.Clusters, name)
+	}
+	for name, ctx := range got.Contexts {
+		s.Contexts[name] = ctx.Cluster
+	}
+	if diff := cmp.Diff(want, s, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("\n%s\nMergeFile(...): -want, +got:\n%s", "Existing entries should be kept and those of the control plane replaced.", diff)
+	}
+}
@@ -0,0 +1,314 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"path"
+	"reflect"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/apis"
+	"github.com/upbound/up-sdk-go/service/common"
+)
+
+const (
+	errExtractList       = "cannot extract items from list"
+	errFmtUnexpectedType = "unexpected object type %T"
+)
+
+// resourceScheme knows every API defined in this project.
+var resourceScheme = sync.OnceValue(func() *runtime.Scheme {
+	s := runtime.NewScheme()
+	metav1.AddToGroupVersion(s, schema.GroupVersion{Version: "v1"})
+	utilruntime.Must(apis.AddToScheme(s))
+	return s
+})
+
+// ResourceClientOptions configure a ResourceClient.
+type ResourceClientOptions struct {
+	// ClusterScoped is true if the resource is not namespaced.
+	ClusterScoped bool
+
+	// Scheme is used to set the apiVersion and kind of objects that are
+	// written without them, and to decode watch events. It defaults to a
+	// scheme that knows every API defined in this project.
+	Scheme *runtime.Scheme
+}
+
+// A ResourceClientOption modifies the options of a ResourceClient.
+type ResourceClientOption func(*ResourceClientOptions)
+
+// WithClusterScope configures a ResourceClient for a cluster scoped resource.
+func WithClusterScope() ResourceClientOption {
+	return func(o *ResourceClientOptions) {
+		o.ClusterScoped = true
+	}
+}
+
+// WithScheme sets the scheme used by a ResourceClient to resolve the kinds
+// of objects and to decode watch events.
+func WithScheme(s *runtime.Scheme) ResourceClientOption {
+	return func(o *ResourceClientOptions) {
+		o.Scheme = s
+	}
+}
+
+// A ResourceClient is a typed client for a kind of resource served by a Spaces
+// API, such as control planes or backups. T is a pointer to the resource's Go
+// type and L is a pointer to its list type, for example:
+//
+//	NewResourceClient[*v1beta1.ControlPlane, *v1beta1.ControlPlaneList](cfg, v1beta1.SchemeGroupVersion.WithResource("controlplanes"))
+//
+// The namespace arguments of its methods are ignored for cluster scoped
+// resources.
+type ResourceClient[T runtime.Object, L runtime.Object] struct {
+	uc       up.Client
+	resource schema.GroupVersionResource
+	opts     ResourceClientOptions
+	decoder  runtime.Decoder
+}
+
+// NewResourceClient creates a new client for the supplied resource.
+func NewResourceClient[T runtime.Object, L runtime.Object](cfg *up.Config, gvr schema.GroupVersionResource, opts ...ResourceClientOption) *ResourceClient[T, L] {
+	o := ResourceClientOptions{}
+	for _, fn := range opts {
+		fn(&o)
+	}
+	if o.Scheme == nil {
+		o.Scheme = resourceScheme()
+	}
+	return &ResourceClient[T, L]{
+		uc: cfg.Client.With(func(c *up.HTTPClient) {
+			c.ErrorHandler = &kubeErrorHandler{}
+		}),
+		resource: gvr,
+		opts:     o,
+		decoder:  serializer.NewCodecFactory(o.Scheme).UniversalDeserializer(),
+	}
+}
+
+// Create creates an object.
+func (c *ResourceClient[T, L]) Create(ctx context.Context, namespace string, obj T, opts *metav1.CreateOptions) (T, error) {
+	return c.write(ctx, http.MethodPost, namespace, "", obj, opts)
+}
+
+// Get gets an object.
+func (c *ResourceClient[T, L]) Get(ctx context.Context, namespace, name string, opts *metav1.GetOptions) (T, error) {
+	res := newObject[T]()
+	if err := c.do(ctx, http.MethodGet, namespace, name, nil, opts, res); err != nil {
+		return *new(T), err
+	}
+	return res, nil
+}
+
+// Update updates an object.
+func (c *ResourceClient[T, L]) Update(ctx context.Context, namespace string, obj T, opts *metav1.UpdateOptions) (T, error) {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return *new(T), err
+	}
+	return c.write(ctx, http.MethodPut, namespace, m.GetName(), obj, opts)
+}
+
+// Patch patches an object. Merge patches and JSON patches are supported.
+func (c *ResourceClient[T, L]) Patch(ctx context.Context, namespace, name string, pt types.PatchType, data []byte, opts *metav1.PatchOptions) (T, error) {
+	if pt != types.MergePatchType && pt != types.JSONPatchType {
+		return *new(T), fmt.Errorf(errFmtUnsupportedPatch, pt)
+	}
+	urlPath, err := c.path(namespace, name, opts)
+	if err != nil {
+		return *new(T), err
+	}
+	req, err := c.uc.NewRequest(ctx, http.MethodPatch, "", urlPath, json.RawMessage(data))
+	if err != nil {
+		return *new(T), err
+	}
+	req.Header.Set("Content-Type", string(pt))
+	res := newObject[T]()
+	if err := c.uc.Do(req, res); err != nil {
+		return *new(T), err
+	}
+	return res, nil
+}
+
+// List lists objects. Objects in all namespaces are listed if namespace is
+// empty.
+func (c *ResourceClient[T, L]) List(ctx context.Context, namespace string, opts *metav1.ListOptions) (L, error) {
+	res := newObject[L]()
+	if err := c.do(ctx, http.MethodGet, namespace, "", nil, opts, res); err != nil {
+		return *new(L), err
+	}
+	return res, nil
+}
+
+// ListAll returns an iterator over all objects. Pages are fetched lazily as
+// the iterator is advanced, using the page size as the list limit and
+// following continuation tokens.
+func (c *ResourceClient[T, L]) ListAll(ctx context.Context, namespace string, opts *metav1.ListOptions, pagerOpts ...common.PagerOption) iter.Seq2[T, error] {
+	return common.NewPager(func(ctx context.Context, p common.PageRequest) (*common.PageResponse[T], error) {
+		o := &metav1.ListOptions{}
+		if opts != nil {
+			o = opts.DeepCopy()
+		}
+		if p.Size > 0 {
+			o.Limit = int64(p.Size)
+		}
+		o.Continue = p.Continue
+		res, err := c.List(ctx, namespace, o)
+		if err != nil {
+			return nil, err
+		}
+		return listPage[T](res)
+	}, pagerOpts...).All(ctx)
+}
+
+// Watch watches objects. The watch is stopped when the context is cancelled
+// or Stop is called on the returned watch.Interface.
+func (c *ResourceClient[T, L]) Watch(ctx context.Context, namespace string, opts *metav1.ListOptions) (watch.Interface, error) {
+	sc, ok := c.uc.(up.StreamingClient)
+	if !ok {
+		return nil, errors.New(errStreamingUnsupported)
+	}
+	o := &metav1.ListOptions{}
+	if opts != nil {
+		o = opts.DeepCopy()
+	}
+	o.Watch = true
+	urlPath, err := c.path(namespace, "", o)
+	if err != nil {
+		return nil, err
+	}
+	req, err := c.uc.NewRequest(ctx, http.MethodGet, "", urlPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := sc.Stream(req)
+	if err != nil {
+		return nil, err
+	}
+	into := func() runtime.Object { return newObject[T]() }
+	return watch.NewStreamWatcher(newWatchDecoder(body, c.decoder, into), &watchErrorReporter{}), nil
+}
+
+// Delete deletes an object.
+func (c *ResourceClient[T, L]) Delete(ctx context.Context, namespace, name string, opts *metav1.DeleteOptions) error {
+	return c.do(ctx, http.MethodDelete, namespace, name, nil, opts, nil)
+}
+
+// write sends the object to the API, setting its apiVersion and kind if they
+// are missing.
+func (c *ResourceClient[T, L]) write(ctx context.Context, method, namespace, name string, obj T, opts runtime.Object) (T, error) {
+	if obj.GetObjectKind().GroupVersionKind().Empty() {
+		obj = obj.DeepCopyObject().(T) //nolint:forcetypeassert // DeepCopyObject returns the same type.
+		obj.GetObjectKind().SetGroupVersionKind(c.kindOf(obj))
+	}
+	res := newObject[T]()
+	if err := c.do(ctx, method, namespace, name, obj, opts, res); err != nil {
+		return *new(T), err
+	}
+	return res, nil
+}
+
+// kindOf returns the kind of the object in the client's scheme, preferring
+// the resource's version. Objects unknown to the scheme are assumed to be of
+// the resource's version and of the kind named like their Go type.
+func (c *ResourceClient[T, L]) kindOf(obj T) schema.GroupVersionKind {
+	gv := c.resource.GroupVersion()
+	gvks, _, err := c.opts.Scheme.ObjectKinds(obj)
+	if err != nil || len(gvks) == 0 {
+		return gv.WithKind(reflect.TypeFor[T]().Elem().Name())
+	}
+	for _, gvk := range gvks {
+		if gvk.GroupVersion() == gv {
+			return gvk
+		}
+	}
+	return gvks[0]
+}
+
+// do sends a request for the resource and reads the response into res.
+func (c *ResourceClient[T, L]) do(ctx context.Context, method, namespace, name string, body interface{}, opts runtime.Object, res interface{}) error {
+	urlPath, err := c.path(namespace, name, opts)
+	if err != nil {
+		return err
+	}
+	req, err := c.uc.NewRequest(ctx, method, "", urlPath, body)
+	if err != nil {
+		return err
+	}
+	return c.uc.Do(req, res)
+}
+
+// path returns the path of the objects in a namespace, or in all namespaces if
+// namespace is empty, or of a single object if name is not empty, with any
+// options encoded as query parameters.
+func (c *ResourceClient[T, L]) path(namespace, name string, opts runtime.Object) (string, error) {
+	gv := c.resource.GroupVersion()
+	prefix := path.Join("apis", gv.Group, gv.Version)
+	if gv.Group == "" {
+		prefix = path.Join("api", gv.Version)
+	}
+	if !c.opts.ClusterScoped && namespace != "" {
+		prefix = path.Join(prefix, "namespaces", namespace)
+	}
+	return withOptions(path.Join(prefix, c.resource.Resource, name), opts)
+}
+
+// listPage returns the items and continuation token of a list.
+func listPage[T runtime.Object](list runtime.Object) (*common.PageResponse[T], error) {
+	objs, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, errors.Wrap(err, errExtractList)
+	}
+	items := make([]T, 0, len(objs))
+	for _, o := range objs {
+		i, ok := o.(T)
+		if !ok {
+			return nil, errors.Errorf(errFmtUnexpectedType, o)
+		}
+		items = append(items, i)
+	}
+	la, err := meta.ListAccessor(list)
+	if err != nil {
+		return nil, errors.Wrap(err, errExtractList)
+	}
+	return &common.PageResponse[T]{
+		Items:    items,
+		Continue: la.GetContinue(),
+		Last:     la.GetContinue() == "",
+	}, nil
+}
+
+// newObject returns a new object of the type pointed to by T.
+func newObject[T runtime.Object]() T {
+	return reflect.New(reflect.TypeFor[T]().Elem()).Interface().(T) //nolint:forcetypeassert // T is a pointer type.
+}
@@ -0,0 +1,258 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/upbound/up-sdk-go"
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	"github.com/upbound/up-sdk-go/service/common"
+)
+
+var controlPlanes = spacesv1beta1.SchemeGroupVersion.WithResource("controlplanes")
+
+func newControlPlaneClient(t *testing.T, h http.HandlerFunc, opts ...ResourceClientOption) *ResourceClient[*spacesv1beta1.ControlPlane, *spacesv1beta1.ControlPlaneList] {
+	t.Helper()
+	s := httptest.NewServer(h)
+	t.Cleanup(s.Close)
+	return NewResourceClient[*spacesv1beta1.ControlPlane, *spacesv1beta1.ControlPlaneList](up.NewConfig(func(cfg *up.Config) {
+		cfg.Client = up.NewClient(func(u *up.HTTPClient) {
+			u.BaseURL = parseURL(t, s.URL)
+			u.HTTP = s.Client()
+		})
+	}), controlPlanes, opts...)
+}
+
+func TestResourceClient_Path(t *testing.T) {
+	type args struct {
+		gvr       schema.GroupVersionResource
+		opts      []ResourceClientOption
+		namespace string
+		name      string
+		options   runtime.Object
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   string
+	}{
+		"Namespaced": {
+			reason: "Namespaced resources should be addressed within their namespace.",
+			args: args{
+				gvr:       controlPlanes,
+				namespace: "default",
+				name:      "cool",
+			},
+			want: "apis/spaces.upbound.io/v1beta1/namespaces/default/controlplanes/cool",
+		},
+		"AllNamespaces": {
+			reason: "Namespaced resources should be addressed across all namespaces if no namespace is supplied.",
+			args: args{
+				gvr: controlPlanes,
+			},
+			want: "apis/spaces.upbound.io/v1beta1/controlplanes",
+		},
+		"ClusterScoped": {
+			reason: "Cluster scoped resources should not be addressed within a namespace.",
+			args: args{
+				gvr:       schema.GroupVersionResource{Group: "connect.upbound.io", Version: "v1alpha1", Resource: "clusterapiservicebindings"},
+				opts:      []ResourceClientOption{WithClusterScope()},
+				namespace: "default",
+			},
+			want: "apis/connect.upbound.io/v1alpha1/clusterapiservicebindings",
+		},
+		"CoreGroup": {
+			reason: "Resources in the core group should be served under /api.",
+			args: args{
+				gvr:       schema.GroupVersionResource{Version: "v1", Resource: "secrets"},
+				namespace: "default",
+				name:      "cool",
+			},
+			want: "api/v1/namespaces/default/secrets/cool",
+		},
+		"Options": {
+			reason: "Options should be encoded as query parameters.",
+			args: args{
+				gvr:       controlPlanes,
+				namespace: "default",
+				options:   &metav1.ListOptions{LabelSelector: "cool=true"},
+			},
+			want: "apis/spaces.upbound.io/v1beta1/namespaces/default/controlplanes?labelSelector=cool%3Dtrue",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewResourceClient[*spacesv1beta1.ControlPlane, *spacesv1beta1.ControlPlaneList](up.NewConfig(), tc.args.gvr, tc.args.opts...)
+			got, err := c.path(tc.args.namespace, tc.args.name, tc.args.options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\npath(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestResourceClient_Create(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		opts   []ResourceClientOption
+		want   metav1.TypeMeta
+	}{
+		"DefaultScheme": {
+			reason: "The apiVersion and kind should be set from the default scheme.",
+			want:   metav1.TypeMeta{APIVersion: spacesv1beta1.SchemeGroupVersion.String(), Kind: spacesv1beta1.ControlPlaneKind},
+		},
+		"UnknownType": {
+			reason: "The apiVersion and kind of types unknown to the scheme should be set from the resource and the type's name.",
+			opts:   []ResourceClientOption{WithScheme(runtime.NewScheme())},
+			want:   metav1.TypeMeta{APIVersion: spacesv1beta1.SchemeGroupVersion.String(), Kind: "ControlPlane"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent metav1.TypeMeta
+			c := newControlPlaneClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/apis/spaces.upbound.io/v1beta1/namespaces/default/controlplanes" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				b, _ := io.ReadAll(r.Body)
+				_ = json.Unmarshal(b, &sent)
+				_, _ = w.Write(b)
+			}, tc.opts...)
+
+			in := &spacesv1beta1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "cool"}}
+			got, err := c.Create(context.Background(), "default", in, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, sent); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff("cool", got.GetName()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", "The created object should be returned.", diff)
+			}
+			if !in.GetObjectKind().GroupVersionKind().Empty() {
+				t.Errorf("\n%s\nCreate(...): modified caller's object", "The supplied object should not be modified.")
+			}
+		})
+	}
+}
+
+func TestResourceClient_List(t *testing.T) {
+	cases := map[string]struct {
+		reason    string
+		namespace string
+		want      string
+	}{
+		"Namespace": {
+			reason:    "Objects should be listed within the supplied namespace.",
+			namespace: "default",
+			want:      "/apis/spaces.upbound.io/v1beta1/namespaces/default/controlplanes",
+		},
+		"AllNamespaces": {
+			reason: "Objects should be listed across all namespaces if no namespace is supplied.",
+			want:   "/apis/spaces.upbound.io/v1beta1/controlplanes",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got string
+			c := newControlPlaneClient(t, func(w http.ResponseWriter, r *http.Request) {
+				got = r.URL.Path
+				_ = json.NewEncoder(w).Encode(&spacesv1beta1.ControlPlaneList{})
+			})
+			if _, err := c.List(context.Background(), tc.namespace, nil); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nList(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestResourceClient_ListAll(t *testing.T) {
+	c := newControlPlaneClient(t, func(w http.ResponseWriter, r *http.Request) {
+		l := &spacesv1beta1.ControlPlaneList{}
+		switch r.URL.Query().Get("continue") {
+		case "":
+			l.Items = []spacesv1beta1.ControlPlane{{ObjectMeta: metav1.ObjectMeta{Name: "a"}}, {ObjectMeta: metav1.ObjectMeta{Name: "b"}}}
+			l.Continue = "next"
+		case "next":
+			l.Items = []spacesv1beta1.ControlPlane{{ObjectMeta: metav1.ObjectMeta{Name: "c"}}}
+		}
+		_ = json.NewEncoder(w).Encode(l)
+	})
+
+	var got []string
+	for cp, err := range c.ListAll(context.Background(), "default", nil, common.WithPageSize(2)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, cp.GetName())
+	}
+	if diff := cmp.Diff([]string{"a", "b", "c"}, got); diff != "" {
+		t.Errorf("\n%s\nListAll(...): -want, +got:\n%s", "All pages should be listed.", diff)
+	}
+}
+
+func TestResourceClient_Watch(t *testing.T) {
+	c := newControlPlaneClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("watch") != "true" {
+			t.Errorf("unexpected watch parameter: %s", r.URL.Query().Get("watch"))
+		}
+		enc := json.NewEncoder(w)
+		cp := &bytes.Buffer{}
+		_ = json.NewEncoder(cp).Encode(&spacesv1beta1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Name: "cool"}})
+		_ = enc.Encode(&metav1.WatchEvent{Type: string(watch.Added), Object: runtime.RawExtension{Raw: cp.Bytes()}})
+		st := &bytes.Buffer{}
+		_ = json.NewEncoder(st).Encode(&metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonExpired})
+		_ = enc.Encode(&metav1.WatchEvent{Type: string(watch.Error), Object: runtime.RawExtension{Raw: st.Bytes()}})
+	})
+	w, err := c.Watch(context.Background(), "default", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+
+	var got []string
+	for e := range w.ResultChan() {
+		switch o := e.Object.(type) {
+		case *spacesv1beta1.ControlPlane:
+			got = append(got, string(e.Type)+":"+o.GetName())
+		case *metav1.Status:
+			got = append(got, string(e.Type)+":"+string(o.Reason))
+		}
+	}
+	want := []string{"ADDED:cool", "ERROR:Expired"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\n%s\nWatch(...): -want, +got:\n%s", "Events should be decoded into the resource type.", diff)
+	}
+}
@@ -0,0 +1,374 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package restore restores control planes in Spaces from backups.
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/apis/common"
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	uerrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/spaces"
+	"github.com/upbound/up-sdk-go/service/wait"
+)
+
+const (
+	errGetBackup          = "cannot get backup"
+	errListBackups        = "cannot list backups of shared backup"
+	errFmtNoBackup        = "backup %s/%s not found"
+	errFmtNoSharedBackup  = "shared backup %s/%s has no backup of control plane %q"
+	errFmtNotCompleted    = "backup %s/%s is %s, not %s"
+	errNoControlPlane     = "control plane of shared backup must be set"
+	errFmtExists          = "control plane %s/%s already exists"
+	errCreateControlPlane = "cannot create control plane"
+	errFmtDeleted         = "control plane %s/%s was deleted while restoring"
+	errWaitRestore        = "cannot wait for restore to finish"
+	errFmtRestoreFailed   = "control plane %s/%s failed to restore: %s"
+)
+
+// A Reason explains why a restore failed.
+type Reason string
+
+// Reasons a restore can fail for.
+const (
+	// ReasonBackupNotFound means the backup to restore from does not exist.
+	ReasonBackupNotFound Reason = "BackupNotFound"
+
+	// ReasonBackupNotCompleted means the backup to restore from has not
+	// completed, or failed.
+	ReasonBackupNotCompleted Reason = "BackupNotCompleted"
+
+	// ReasonResolveFailed means the backup to restore from could not be
+	// read.
+	ReasonResolveFailed Reason = "ResolveFailed"
+
+	// ReasonControlPlaneExists means a control plane with the name of the
+	// restored control plane already exists.
+	ReasonControlPlaneExists Reason = "ControlPlaneExists"
+
+	// ReasonCreateFailed means the restored control plane could not be
+	// created.
+	ReasonCreateFailed Reason = "CreateFailed"
+
+	// ReasonControlPlaneDeleted means the restored control plane was deleted
+	// before the restore finished.
+	ReasonControlPlaneDeleted Reason = "ControlPlaneDeleted"
+
+	// ReasonRestoreFailed means the restored control plane reported that the
+	// restore failed.
+	ReasonRestoreFailed Reason = "RestoreFailed"
+
+	// ReasonWaitFailed means the restore did not finish in time, or the
+	// restored control plane could not be observed.
+	ReasonWaitFailed Reason = "WaitFailed"
+)
+
+// An Error is a failed restore.
+type Error struct {
+	// Reason the restore failed.
+	Reason Reason
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error returns the error message with the underlying error.
+func (e *Error) Error() string {
+	return fmt.Sprintf("restore failed (%s): %v", e.Reason, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ReasonOf returns the reason a restore failed, if the error is a failed
+// restore.
+func ReasonOf(err error) (Reason, bool) {
+	var e *Error
+	if !errors.As(err, &e) {
+		return "", false
+	}
+	return e.Reason, true
+}
+
+func fail(r Reason, err error) error {
+	return &Error{Reason: r, Err: err}
+}
+
+// A Phase of a restore.
+type Phase string
+
+// Phases of a restore, in order.
+const (
+	// PhaseValidated means the backup to restore from was found and has
+	// completed.
+	PhaseValidated Phase = "Validated"
+
+	// PhaseCreated means the restored control plane was created.
+	PhaseCreated Phase = "Created"
+
+	// PhaseRestoring means the restored control plane was observed, but the
+	// restore has not finished yet.
+	PhaseRestoring Phase = "Restoring"
+
+	// PhaseRestored means the restore finished.
+	PhaseRestored Phase = "Restored"
+)
+
+// Progress of a restore.
+type Progress struct {
+	// Phase of the restore.
+	Phase Phase
+
+	// Message describes the progress for humans.
+	Message string
+
+	// Backup is the backup being restored from.
+	Backup *spacesv1beta1.Backup
+
+	// ControlPlane is the last observed state of the restored control plane,
+	// once it was created.
+	ControlPlane *spacesv1beta1.ControlPlane
+}
+
+// A Source is the backup to restore from.
+type Source struct {
+	// Namespace of the backup.
+	Namespace string
+
+	// Name of the Backup, or of the SharedBackup if ControlPlane is set.
+	Name string
+
+	// ControlPlane is the name of the control plane whose backup by a
+	// SharedBackup to restore from. It is empty for Backups.
+	ControlPlane string
+}
+
+// FromBackup returns the source of a Backup.
+func FromBackup(namespace, name string) Source {
+	return Source{Namespace: namespace, Name: name}
+}
+
+// FromSharedBackup returns the source of the backup of a control plane by a
+// SharedBackup.
+func FromSharedBackup(namespace, name, controlPlane string) Source {
+	return Source{Namespace: namespace, Name: name, ControlPlane: controlPlane}
+}
+
+type options struct {
+	progress func(Progress)
+	mutate   []func(*spacesv1beta1.ControlPlane)
+	wait     []wait.Option
+}
+
+// An Option modifies how control planes are restored.
+type Option func(*options)
+
+// WithProgress calls fn with the progress of the restore.
+func WithProgress(fn func(Progress)) Option {
+	return func(o *options) {
+		o.progress = fn
+	}
+}
+
+// WithControlPlane calls fn with the restored control plane before it is
+// created, e.g. to set its class or Crossplane version.
+func WithControlPlane(fn func(*spacesv1beta1.ControlPlane)) Option {
+	return func(o *options) {
+		o.mutate = append(o.mutate, fn)
+	}
+}
+
+// WithWaitOptions sets how the restore is waited for, e.g. its timeout.
+func WithWaitOptions(opts ...wait.Option) Option {
+	return func(o *options) {
+		o.wait = append(o.wait, opts...)
+	}
+}
+
+// BackupClient is a client of Spaces v1beta1 Backups.
+type BackupClient = spaces.ResourceClient[*spacesv1beta1.Backup, *spacesv1beta1.BackupList]
+
+// Client restores control planes.
+type Client struct {
+	backups       *BackupClient
+	controlPlanes *wait.ControlPlaneClient
+}
+
+// NewClient creates a new restore client.
+func NewClient(cfg *up.Config) *Client {
+	return &Client{
+		backups:       spaces.NewResourceClient[*spacesv1beta1.Backup, *spacesv1beta1.BackupList](cfg, spacesv1beta1.SchemeGroupVersion.WithResource("backups")),
+		controlPlanes: spaces.NewResourceClient[*spacesv1beta1.ControlPlane, *spacesv1beta1.ControlPlaneList](cfg, spacesv1beta1.SchemeGroupVersion.WithResource("controlplanes")),
+	}
+}
+
+// Restore creates a control plane of the supplied name in the namespace of
+// the backup, restored from the backup, and waits for the restore to finish.
+// The backup must have completed. It returns the restored control plane, or an
+// *Error explaining why the restore failed.
+func (c *Client) Restore(ctx context.Context, from Source, name string, opts ...Option) (*spacesv1beta1.ControlPlane, error) {
+	o := &options{}
+	for _, fn := range opts {
+		fn(o)
+	}
+	report := func(p Progress) {
+		if o.progress != nil {
+			o.progress(p)
+		}
+	}
+
+	b, err := c.resolve(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	if b.Status.Phase != spacesv1beta1.BackupPhaseCompleted {
+		return nil, fail(ReasonBackupNotCompleted, errors.Errorf(errFmtNotCompleted, b.GetNamespace(), b.GetName(), phaseOf(b), spacesv1beta1.BackupPhaseCompleted))
+	}
+	report(Progress{Phase: PhaseValidated, Message: fmt.Sprintf("backup %s/%s has completed", b.GetNamespace(), b.GetName()), Backup: b})
+
+	cp, err := c.controlPlanes.Create(ctx, b.GetNamespace(), NewControlPlane(b, name, o.mutate...), nil)
+	if uerrors.IsAlreadyExists(err) {
+		return nil, fail(ReasonControlPlaneExists, errors.Errorf(errFmtExists, b.GetNamespace(), name))
+	}
+	if err != nil {
+		return nil, fail(ReasonCreateFailed, errors.Wrap(err, errCreateControlPlane))
+	}
+	report(Progress{Phase: PhaseCreated, Message: fmt.Sprintf("created control plane %s/%s", cp.GetNamespace(), cp.GetName()), Backup: b, ControlPlane: cp})
+
+	err = wait.WaitFor(ctx, wait.ControlPlane(c.controlPlanes, cp.GetNamespace(), cp.GetName()), func(obs wait.Observation) (bool, error) {
+		if obs.Deleted {
+			return false, fail(ReasonControlPlaneDeleted, errors.Errorf(errFmtDeleted, cp.GetNamespace(), cp.GetName()))
+		}
+		observed, ok := obs.Object.(*spacesv1beta1.ControlPlane)
+		if !ok {
+			return false, nil
+		}
+		cp = observed
+		if msg, failed := restoreFailure(cp); failed {
+			return false, fail(ReasonRestoreFailed, errors.Errorf(errFmtRestoreFailed, cp.GetNamespace(), cp.GetName(), msg))
+		}
+		if Finished(cp) {
+			report(Progress{Phase: PhaseRestored, Message: fmt.Sprintf("restored at %s", cp.Spec.Restore.FinishedAt.UTC()), Backup: b, ControlPlane: cp})
+			return true, nil
+		}
+		report(Progress{Phase: PhaseRestoring, Message: restoringMessage(cp), Backup: b, ControlPlane: cp})
+		return false, nil
+	}, o.wait...)
+	if _, ok := ReasonOf(err); ok {
+		return cp, err
+	}
+	if err != nil {
+		return cp, fail(ReasonWaitFailed, errors.Wrap(err, errWaitRestore))
+	}
+	return cp, nil
+}
+
+// resolve returns the Backup of the source.
+func (c *Client) resolve(ctx context.Context, from Source) (*spacesv1beta1.Backup, error) {
+	if from.ControlPlane == "" {
+		b, err := c.backups.Get(ctx, from.Namespace, from.Name, nil)
+		if uerrors.IsNotFound(err) {
+			return nil, fail(ReasonBackupNotFound, errors.Errorf(errFmtNoBackup, from.Namespace, from.Name))
+		}
+		if err != nil {
+			return nil, fail(ReasonResolveFailed, errors.Wrap(err, errGetBackup))
+		}
+		return b, nil
+	}
+
+	sel := labels.SelectorFromSet(labels.Set{spacesv1beta1.SharedBackupLabelKey: from.Name})
+	l, err := c.backups.List(ctx, from.Namespace, &metav1.ListOptions{LabelSelector: sel.String()})
+	if err != nil {
+		return nil, fail(ReasonResolveFailed, errors.Wrap(err, errListBackups))
+	}
+	for i := range l.Items {
+		if l.Items[i].Spec.ControlPlane == from.ControlPlane {
+			return &l.Items[i], nil
+		}
+	}
+	return nil, fail(ReasonBackupNotFound, errors.Errorf(errFmtNoSharedBackup, from.Namespace, from.Name, from.ControlPlane))
+}
+
+// NewControlPlane returns a control plane of the supplied name restored from
+// the backup, modified by the supplied functions.
+func NewControlPlane(b *spacesv1beta1.Backup, name string, fns ...func(*spacesv1beta1.ControlPlane)) *spacesv1beta1.ControlPlane {
+	group := spacesv1beta1.Group
+	cp := &spacesv1beta1.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: b.GetNamespace(), Name: name},
+		Spec: spacesv1beta1.ControlPlaneSpec{
+			Restore: &spacesv1beta1.Restore{
+				Source: common.TypedLocalObjectReference{APIGroup: &group, Kind: spacesv1beta1.BackupKind, Name: b.GetName()},
+			},
+		},
+	}
+	for _, fn := range fns {
+		fn(cp)
+	}
+	return cp
+}
+
+// Finished returns true if the control plane was restored.
+func Finished(cp *spacesv1beta1.ControlPlane) bool {
+	return cp.Spec.Restore != nil && cp.Spec.Restore.FinishedAt != nil
+}
+
+func phaseOf(b *spacesv1beta1.Backup) spacesv1beta1.BackupPhase {
+	if b.Status.Phase == "" {
+		return spacesv1beta1.BackupPhasePending
+	}
+	return b.Status.Phase
+}
+
+// restoreFailure returns why the restore of the control plane failed, if its
+// Ready or Restored condition reports a failed restore.
+func restoreFailure(cp *spacesv1beta1.ControlPlane) (string, bool) {
+	for _, t := range []xpv1.ConditionType{xpv1.TypeReady, spacesv1beta1.ConditionTypeRestored} {
+		c := cp.GetCondition(t)
+		if c.Status != corev1.ConditionFalse || c.Reason != spacesv1beta1.ReasonRestoreFailed {
+			continue
+		}
+		if c.Message != "" {
+			return c.Message, true
+		}
+		return string(c.Reason), true
+	}
+	return "", false
+}
+
+func restoringMessage(cp *spacesv1beta1.ControlPlane) string {
+	c := cp.GetCondition(xpv1.TypeReady)
+	switch {
+	case cp.Status.Message != "":
+		return cp.Status.Message
+	case c.Message != "":
+		return c.Message
+	case c.Reason != "":
+		return fmt.Sprintf("waiting for restore to finish, control plane is %s", c.Reason)
+	default:
+		return "waiting for restore to finish"
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/apis/common"
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	"github.com/upbound/up-sdk-go/service/wait"
+)
+
+const basePath = "/apis/spaces.upbound.io/v1beta1/namespaces/default/"
+
+// server fakes the Spaces API. The restored control plane is observed as
+// restoring until it was observed polls times, then as restored.
+type server struct {
+	backups []spacesv1beta1.Backup
+	exists  bool
+	deleted bool
+	failed  bool
+	polls   int
+
+	created *spacesv1beta1.ControlPlane
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	backupName, isBackup := strings.CutPrefix(r.URL.Path, basePath+"backups/")
+	status := func(err *apierrors.StatusError) {
+		st := err.ErrStatus
+		st.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Status"}
+		w.WriteHeader(int(st.Code))
+		_ = enc.Encode(st)
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == basePath+"backups":
+		l := &spacesv1beta1.BackupList{}
+		sel := r.URL.Query().Get("labelSelector")
+		for _, b := range s.backups {
+			if v, ok := b.GetLabels()[spacesv1beta1.SharedBackupLabelKey]; ok && sel == spacesv1beta1.SharedBackupLabelKey+"="+v {
+				l.Items = append(l.Items, b)
+			}
+		}
+		_ = enc.Encode(l)
+	case r.Method == http.MethodGet && isBackup:
+		for _, b := range s.backups {
+			if b.GetName() == backupName {
+				_ = enc.Encode(b)
+				return
+			}
+		}
+		status(apierrors.NewNotFound(schema.GroupResource{Group: spacesv1beta1.Group, Resource: "backups"}, backupName))
+	case r.Method == http.MethodPost && r.URL.Path == basePath+"controlplanes":
+		cp := &spacesv1beta1.ControlPlane{}
+		_ = json.NewDecoder(r.Body).Decode(cp)
+		if s.exists {
+			status(apierrors.NewAlreadyExists(schema.GroupResource{Group: spacesv1beta1.Group, Resource: "controlplanes"}, cp.GetName()))
+			return
+		}
+		s.created = cp
+		_ = enc.Encode(cp)
+	case r.Method == http.MethodGet && s.created != nil && r.URL.Path == basePath+"controlplanes/"+s.created.GetName():
+		if s.deleted {
+			status(apierrors.NewNotFound(schema.GroupResource{Group: spacesv1beta1.Group, Resource: "controlplanes"}, s.created.GetName()))
+			return
+		}
+		cp := s.created.DeepCopy()
+		switch s.polls--; {
+		case s.polls < 0 && s.failed:
+			cp.SetConditions(xpv1.Condition{Type: xpv1.TypeReady, Status: corev1.ConditionFalse, Reason: spacesv1beta1.ReasonRestoreFailed, Message: "backup is corrupt"})
+		case s.polls < 0:
+			finished := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+			cp.Spec.Restore.FinishedAt = &finished
+		}
+		_ = enc.Encode(cp)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newConfig(t *testing.T, h http.Handler) *up.Config {
+	t.Helper()
+	s := httptest.NewServer(h)
+	t.Cleanup(s.Close)
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return up.NewConfig(func(cfg *up.Config) {
+		cfg.Client = up.NewClient(func(c *up.HTTPClient) {
+			c.BaseURL = u
+			c.HTTP = s.Client()
+		})
+	})
+}
+
+func backup(name string, phase spacesv1beta1.BackupPhase, labels map[string]string, controlPlane string) spacesv1beta1.Backup {
+	return spacesv1beta1.Backup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Labels: labels},
+		Spec:       spacesv1beta1.BackupSpec{ControlPlane: controlPlane},
+		Status:     spacesv1beta1.BackupStatus{Phase: phase},
+	}
+}
+
+func TestRestore(t *testing.T) {
+	shared := map[string]string{spacesv1beta1.SharedBackupLabelKey: "nightly"}
+
+	type want struct {
+		source string
+		phases []Phase
+		reason Reason
+	}
+	cases := map[string]struct {
+		reason string
+		server *server
+		from   Source
+		want   want
+	}{
+		"FromBackup": {
+			reason: "A control plane should be created from a completed backup and waited for until restored.",
+			server: &server{backups: []spacesv1beta1.Backup{backup("b1", spacesv1beta1.BackupPhaseCompleted, nil, "ctp1")}, polls: 2},
+			from:   FromBackup("default", "b1"),
+			want: want{
+				source: "b1",
+				phases: []Phase{PhaseValidated, PhaseCreated, PhaseRestoring, PhaseRestoring, PhaseRestored},
+			},
+		},
+		"FromSharedBackup": {
+			reason: "The backup of the control plane by the shared backup should be restored from.",
+			server: &server{backups: []spacesv1beta1.Backup{
+				backup("nightly-ctp1", spacesv1beta1.BackupPhaseCompleted, shared, "ctp1"),
+				backup("nightly-ctp2", spacesv1beta1.BackupPhaseCompleted, shared, "ctp2"),
+			}},
+			from: FromSharedBackup("default", "nightly", "ctp2"),
+			want: want{
+				source: "nightly-ctp2",
+				phases: []Phase{PhaseValidated, PhaseCreated, PhaseRestored},
+			},
+		},
+		"BackupNotFound": {
+			reason: "Restoring from a backup that does not exist should fail.",
+			server: &server{},
+			from:   FromBackup("default", "b1"),
+			want:   want{reason: ReasonBackupNotFound},
+		},
+		"SharedBackupNotFound": {
+			reason: "Restoring a control plane the shared backup did not back up should fail.",
+			server: &server{backups: []spacesv1beta1.Backup{backup("nightly-ctp1", spacesv1beta1.BackupPhaseCompleted, shared, "ctp1")}},
+			from:   FromSharedBackup("default", "nightly", "ctp2"),
+			want:   want{reason: ReasonBackupNotFound},
+		},
+		"BackupFailed": {
+			reason: "Restoring from a failed backup should fail.",
+			server: &server{backups: []spacesv1beta1.Backup{backup("b1", spacesv1beta1.BackupPhaseFailed, nil, "ctp1")}},
+			from:   FromBackup("default", "b1"),
+			want:   want{reason: ReasonBackupNotCompleted},
+		},
+		"ControlPlaneExists": {
+			reason: "Restoring to an existing control plane should fail.",
+			server: &server{backups: []spacesv1beta1.Backup{backup("b1", spacesv1beta1.BackupPhaseCompleted, nil, "ctp1")}, exists: true},
+			from:   FromBackup("default", "b1"),
+			want:   want{phases: []Phase{PhaseValidated}, reason: ReasonControlPlaneExists},
+		},
+		"RestoreFailed": {
+			reason: "A control plane reporting a failed restore should fail the restore without waiting for it to finish.",
+			server: &server{backups: []spacesv1beta1.Backup{backup("b1", spacesv1beta1.BackupPhaseCompleted, nil, "ctp1")}, failed: true, polls: 1},
+			from:   FromBackup("default", "b1"),
+			want:   want{source: "b1", phases: []Phase{PhaseValidated, PhaseCreated, PhaseRestoring}, reason: ReasonRestoreFailed},
+		},
+		"ControlPlaneDeleted": {
+			reason: "A control plane deleted while restoring should fail the restore.",
+			server: &server{backups: []spacesv1beta1.Backup{backup("b1", spacesv1beta1.BackupPhaseCompleted, nil, "ctp1")}, deleted: true},
+			from:   FromBackup("default", "b1"),
+			want:   want{source: "b1", phases: []Phase{PhaseValidated, PhaseCreated}, reason: ReasonControlPlaneDeleted},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(newConfig(t, tc.server))
+			got := want{}
+			_, err := c.Restore(context.Background(), tc.from, "restored",
+				WithProgress(func(p Progress) { got.phases = append(got.phases, p.Phase) }),
+				WithWaitOptions(wait.WithPolling(), wait.WithInterval(time.Millisecond), wait.WithTimeout(10*time.Second)),
+			)
+			got.reason, _ = ReasonOf(err)
+			if tc.server.created != nil {
+				got.source = tc.server.created.Spec.Restore.Source.Name
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nRestore(...): -want, +got:\n%s\n%v", tc.reason, diff, err)
+			}
+		})
+	}
+}
+
+func TestNewControlPlane(t *testing.T) {
+	b := backup("b1", spacesv1beta1.BackupPhaseCompleted, nil, "ctp1")
+	cp := NewControlPlane(&b, "restored", func(cp *spacesv1beta1.ControlPlane) { cp.Spec.Class = "small" })
+
+	group := spacesv1beta1.Group
+	want := &spacesv1beta1.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "restored"},
+		Spec: spacesv1beta1.ControlPlaneSpec{
+			Class: "small",
+			Restore: &spacesv1beta1.Restore{
+				Source: common.TypedLocalObjectReference{APIGroup: &group, Kind: "Backup", Name: "b1"},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, cp); diff != "" {
+		t.Errorf("NewControlPlane(...): -want, +got:\n%s", diff)
+	}
+}
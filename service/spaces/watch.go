@@ -0,0 +1,85 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spaces
+
+import (
+	"encoding/json"
+	"io"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+const errDecodeWatchEvent = "cannot decode watch event object"
+
+var _ watch.Decoder = &watchDecoder{}
+
+// watchDecoder decodes the watch events streamed by a Spaces API.
+type watchDecoder struct {
+	body    io.ReadCloser
+	decoder *json.Decoder
+	codec   runtime.Decoder
+	into    func() runtime.Object
+}
+
+// newWatchDecoder returns a decoder of the watch events streamed in body.
+// Event objects are decoded into the objects returned by into, if it is not
+// nil, or into the type the codec resolves for them otherwise.
+func newWatchDecoder(body io.ReadCloser, d runtime.Decoder, into func() runtime.Object) *watchDecoder {
+	return &watchDecoder{body: body, decoder: json.NewDecoder(body), codec: d, into: into}
+}
+
+// Decode blocks until it can return the next event in the stream.
+func (d *watchDecoder) Decode() (watch.EventType, runtime.Object, error) {
+	e := &metav1.WatchEvent{}
+	if err := d.decoder.Decode(e); err != nil {
+		return "", nil, err
+	}
+	// Error events carry a Status rather than an object being watched.
+	if watch.EventType(e.Type) == watch.Error {
+		st := &metav1.Status{}
+		if err := json.Unmarshal(e.Object.Raw, st); err != nil {
+			return "", nil, errors.Wrap(err, errDecodeWatchEvent)
+		}
+		return watch.Error, st, nil
+	}
+	var into runtime.Object
+	if d.into != nil {
+		into = d.into()
+	}
+	obj, _, err := d.codec.Decode(e.Object.Raw, nil, into)
+	if err != nil {
+		return "", nil, errors.Wrap(err, errDecodeWatchEvent)
+	}
+	return watch.EventType(e.Type), obj, nil
+}
+
+// Close closes the underlying stream.
+func (d *watchDecoder) Close() {
+	_ = d.body.Close()
+}
+
+// watchErrorReporter reports errors decoding a watch stream as error events.
+type watchErrorReporter struct{}
+
+// AsObject returns the error as a Status object.
+func (r *watchErrorReporter) AsObject(err error) runtime.Object {
+	st := apierrors.NewInternalError(err).Status()
+	return &st
+}
@@ -17,11 +17,13 @@ package users
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"path"
 	"strconv"
 
 	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/common"
 	"github.com/upbound/up-sdk-go/service/tokens"
 )
 
@@ -55,3 +57,14 @@ func (c *Client) ListTokens(ctx context.Context, userID uint) (*tokens.TokensRes
 	}
 	return r, nil
 }
+
+// ListAllTokens returns an iterator over all tokens for a user on Upbound.
+func (c *Client) ListAllTokens(ctx context.Context, userID uint, opts ...common.PagerOption) iter.Seq2[common.DataSet, error] {
+	return common.NewPager(common.SinglePage(func(ctx context.Context) ([]common.DataSet, error) {
+		res, err := c.ListTokens(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		return res.DataSet, nil
+	}), opts...).All(ctx)
+}
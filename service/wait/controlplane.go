@@ -0,0 +1,139 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	uerrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/controlplanes"
+	"github.com/upbound/up-sdk-go/service/spaces"
+)
+
+// ReasonUpdating is the reason of the Ready condition of legacy control
+// planes that are being updated.
+const ReasonUpdating xpv1.ConditionReason = "Updating"
+
+// ControlPlaneClient is a client of Spaces v1beta1 ControlPlanes.
+type ControlPlaneClient = spaces.ResourceClient[*spacesv1beta1.ControlPlane, *spacesv1beta1.ControlPlaneList]
+
+// LegacyControlPlane returns an Object for a control plane of the legacy
+// Upbound REST API. Its status is observed as a Ready condition.
+func LegacyControlPlane(c *controlplanes.Client, account, name string) Object {
+	return &legacyControlPlane{client: c, account: account, name: name}
+}
+
+type legacyControlPlane struct {
+	client  *controlplanes.Client
+	account string
+	name    string
+}
+
+func (l *legacyControlPlane) Observe(ctx context.Context) (Observation, error) {
+	cp, err := l.client.Get(ctx, l.account, l.name)
+	if uerrors.IsNotFound(err) {
+		return Observation{Deleted: true}, nil
+	}
+	if err != nil {
+		return Observation{}, err
+	}
+	return Observation{Conditions: []xpv1.Condition{LegacyReadyCondition(cp.Status)}}, nil
+}
+
+// LegacyReadyCondition returns the Ready condition corresponding to the
+// status of a legacy control plane.
+func LegacyReadyCondition(s controlplanes.Status) xpv1.Condition {
+	switch s {
+	case controlplanes.StatusReady:
+		return xpv1.Available()
+	case controlplanes.StatusProvisioning:
+		return xpv1.Creating()
+	case controlplanes.StatusDeleting:
+		return xpv1.Deleting()
+	case controlplanes.StatusUpdating:
+		return xpv1.Condition{Type: xpv1.TypeReady, Status: corev1.ConditionFalse, Reason: ReasonUpdating}
+	default:
+		return xpv1.Condition{Type: xpv1.TypeReady, Status: corev1.ConditionUnknown}
+	}
+}
+
+// ControlPlane returns an Object for a Spaces v1beta1 ControlPlane. It is
+// watched if the client supports streaming.
+func ControlPlane(c *ControlPlaneClient, namespace, name string) Watcher {
+	return &controlPlane{client: c, namespace: namespace, name: name}
+}
+
+type controlPlane struct {
+	client    *ControlPlaneClient
+	namespace string
+	name      string
+}
+
+func (c *controlPlane) Observe(ctx context.Context) (Observation, error) {
+	cp, err := c.client.Get(ctx, c.namespace, c.name, nil)
+	if uerrors.IsNotFound(err) {
+		return Observation{Deleted: true}, nil
+	}
+	if err != nil {
+		return Observation{}, err
+	}
+	return Observation{Conditions: cp.Status.Conditions, Object: cp}, nil
+}
+
+func (c *controlPlane) Watch(ctx context.Context) (<-chan Observation, error) {
+	w, err := c.client.Watch(ctx, c.namespace, &metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", c.name).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan Observation)
+	go func() {
+		defer close(ch)
+		defer w.Stop()
+		for e := range w.ResultChan() {
+			var obs Observation
+			switch e.Type {
+			case watch.Added, watch.Modified:
+				cp, ok := e.Object.(*spacesv1beta1.ControlPlane)
+				if !ok {
+					continue
+				}
+				obs.Conditions, obs.Object = cp.Status.Conditions, cp
+			case watch.Deleted:
+				obs.Deleted = true
+			case watch.Error:
+				// The watch is restarted by the caller.
+				return
+			default:
+				continue
+			}
+			select {
+			case ch <- obs:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
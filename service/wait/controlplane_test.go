@@ -0,0 +1,143 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	"github.com/upbound/up-sdk-go"
+	spacesv1beta1 "github.com/upbound/up-sdk-go/apis/spaces/v1beta1"
+	"github.com/upbound/up-sdk-go/service/controlplanes"
+	"github.com/upbound/up-sdk-go/service/spaces"
+)
+
+func newConfig(t *testing.T, h http.HandlerFunc) *up.Config {
+	t.Helper()
+	s := httptest.NewServer(h)
+	t.Cleanup(s.Close)
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return up.NewConfig(func(cfg *up.Config) {
+		cfg.Client = up.NewClient(func(c *up.HTTPClient) {
+			c.BaseURL = u
+			c.HTTP = s.Client()
+		})
+	})
+}
+
+func withReady(s corev1.ConditionStatus) *spacesv1beta1.ControlPlane {
+	cp := &spacesv1beta1.ControlPlane{
+		TypeMeta:   metav1.TypeMeta{APIVersion: spacesv1beta1.SchemeGroupVersion.String(), Kind: "ControlPlane"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ctp1"},
+	}
+	cp.SetConditions(xpv1.Condition{Type: xpv1.TypeReady, Status: s})
+	return cp
+}
+
+func TestControlPlane(t *testing.T) {
+	cfg := newConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		enc := json.NewEncoder(w)
+		if r.URL.Query().Get("watch") != "true" {
+			_ = enc.Encode(withReady(corev1.ConditionFalse))
+			return
+		}
+		if got := r.URL.Query().Get("fieldSelector"); got != "metadata.name=ctp1" {
+			t.Errorf("unexpected field selector: %s", got)
+		}
+		for _, s := range []corev1.ConditionStatus{corev1.ConditionFalse, corev1.ConditionTrue} {
+			b := &bytes.Buffer{}
+			_ = json.NewEncoder(b).Encode(withReady(s))
+			_ = enc.Encode(&metav1.WatchEvent{Type: string(watch.Modified), Object: runtime.RawExtension{Raw: b.Bytes()}})
+		}
+	})
+	c := spaces.NewResourceClient[*spacesv1beta1.ControlPlane, *spacesv1beta1.ControlPlaneList](cfg, spacesv1beta1.SchemeGroupVersion.WithResource("controlplanes"))
+
+	var got []corev1.ConditionStatus
+	err := WaitForReady(context.Background(), ControlPlane(c, "default", "ctp1"), WithTimeout(10*time.Second), WithProgress(func(o Observation) {
+		got = append(got, o.GetCondition(xpv1.TypeReady).Status)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []corev1.ConditionStatus{corev1.ConditionFalse, corev1.ConditionFalse, corev1.ConditionTrue}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\n%s\nWaitForReady(...): -want, +got:\n%s", "The control plane should be observed, then watched until it is ready.", diff)
+	}
+}
+
+func TestLegacyControlPlane(t *testing.T) {
+	calls := 0
+	cfg := newConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/controlPlanes/upbound/ctp1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		calls++
+		if calls > 2 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&controlplanes.ControlPlaneResponse{Status: controlplanes.StatusDeleting})
+	})
+	obj := LegacyControlPlane(controlplanes.NewClient(cfg), "upbound", "ctp1")
+
+	var got []xpv1.ConditionReason
+	err := WaitForDeleted(context.Background(), obj, WithInterval(time.Millisecond), WithTimeout(10*time.Second), WithProgress(func(o Observation) {
+		got = append(got, o.GetCondition(xpv1.TypeReady).Reason)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []xpv1.ConditionReason{xpv1.ReasonDeleting, xpv1.ReasonDeleting, ""}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("\n%s\nWaitForDeleted(...): -want, +got:\n%s", "The control plane should be polled until it is not found.", diff)
+	}
+}
+
+func TestLegacyReadyCondition(t *testing.T) {
+	cases := map[string]struct {
+		status controlplanes.Status
+		want   xpv1.ConditionReason
+	}{
+		"Ready":        {status: controlplanes.StatusReady, want: xpv1.ReasonAvailable},
+		"Provisioning": {status: controlplanes.StatusProvisioning, want: xpv1.ReasonCreating},
+		"Updating":     {status: controlplanes.StatusUpdating, want: ReasonUpdating},
+		"Deleting":     {status: controlplanes.StatusDeleting, want: xpv1.ReasonDeleting},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, LegacyReadyCondition(tc.status).Reason); diff != "" {
+				t.Errorf("LegacyReadyCondition(%q): -want, +got:\n%s", tc.status, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wait waits for control planes to become ready, to be deleted, or to
+// reach a condition.
+package wait
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+const (
+	defaultInterval = 5 * time.Second
+
+	errFmtWaitCondition = "cannot wait for condition %s=%s"
+	errFmtDeleted       = "object was deleted while waiting for condition %s=%s"
+	errWaitDeleted      = "cannot wait for deletion"
+)
+
+// An Observation is the state of an object observed while waiting.
+type Observation struct {
+	// Conditions are the conditions of the object.
+	Conditions []xpv1.Condition
+
+	// Deleted is true if the object does not exist.
+	Deleted bool
+
+	// Object is the observed object, if known.
+	Object runtime.Object
+}
+
+// GetCondition returns the condition of the supplied type. A condition of
+// status Unknown is returned if the object does not have it.
+func (o Observation) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	for _, c := range o.Conditions {
+		if c.Type == ct {
+			return c
+		}
+	}
+	return xpv1.Condition{Type: ct, Status: corev1.ConditionUnknown}
+}
+
+// An Object can be waited for.
+type Object interface {
+	// Observe returns the current state of the object. An object that does
+	// not exist is observed as deleted rather than returning an error.
+	Observe(ctx context.Context) (Observation, error)
+}
+
+// A Watcher is an Object that can be watched for changes, rather than
+// polled.
+type Watcher interface {
+	Object
+
+	// Watch streams observations of the object until the context is done or
+	// the watch ends, at which point the channel is closed.
+	Watch(ctx context.Context) (<-chan Observation, error)
+}
+
+type options struct {
+	interval time.Duration
+	timeout  time.Duration
+	progress func(Observation)
+	poll     bool
+}
+
+// An Option modifies how objects are waited for.
+type Option func(*options)
+
+// WithInterval sets the interval objects are polled at if they can't be
+// watched. It defaults to five seconds.
+func WithInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.interval = d
+	}
+}
+
+// WithTimeout sets how long to wait for. By default waiting is only bounded
+// by the supplied context.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+// WithProgress calls fn with every observation of the object.
+func WithProgress(fn func(Observation)) Option {
+	return func(o *options) {
+		o.progress = fn
+	}
+}
+
+// WithPolling polls objects even if they can be watched.
+func WithPolling() Option {
+	return func(o *options) {
+		o.poll = true
+	}
+}
+
+// WaitForReady waits until the object is Ready.
+func WaitForReady(ctx context.Context, obj Object, opts ...Option) error {
+	return WaitForCondition(ctx, obj, xpv1.TypeReady, corev1.ConditionTrue, opts...)
+}
+
+// WaitForCondition waits until the object has a condition of the supplied
+// type and status. It returns an error if the object is deleted while
+// waiting.
+func WaitForCondition(ctx context.Context, obj Object, ct xpv1.ConditionType, status corev1.ConditionStatus, opts ...Option) error {
+	err := WaitFor(ctx, obj, func(o Observation) (bool, error) {
+		if o.Deleted {
+			return false, errors.Errorf(errFmtDeleted, ct, status)
+		}
+		return o.GetCondition(ct).Status == status, nil
+	}, opts...)
+	return errors.Wrapf(err, errFmtWaitCondition, ct, status)
+}
+
+// WaitForDeleted waits until the object does not exist.
+func WaitForDeleted(ctx context.Context, obj Object, opts ...Option) error {
+	err := WaitFor(ctx, obj, func(o Observation) (bool, error) {
+		return o.Deleted, nil
+	}, opts...)
+	return errors.Wrap(err, errWaitDeleted)
+}
+
+// WaitFor observes the object until done returns true or an error. Objects
+// are watched if they can be, falling back to polling if the watch can't be
+// started. A watch that ends is restarted after an interval, observing the
+// object again first so that no changes are missed.
+func WaitFor(ctx context.Context, obj Object, done func(Observation) (bool, error), opts ...Option) error {
+	o := &options{interval: defaultInterval}
+	for _, fn := range opts {
+		fn(o)
+	}
+	if o.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+	check := func(obs Observation) (bool, error) {
+		if o.progress != nil {
+			o.progress(obs)
+		}
+		return done(obs)
+	}
+
+	w, watchable := obj.(Watcher)
+	watchable = watchable && !o.poll
+	for {
+		obs, err := obj.Observe(ctx)
+		if err != nil {
+			return err
+		}
+		if ok, err := check(obs); ok || err != nil {
+			return err
+		}
+
+		if watchable {
+			wctx, cancel := context.WithCancel(ctx)
+			ch, err := w.Watch(wctx)
+			if err == nil {
+				ok, err := drain(ctx, ch, check)
+				cancel()
+				if ok || err != nil {
+					return err
+				}
+			} else {
+				cancel()
+				// Fall back to polling objects that can't be watched.
+				watchable = false
+			}
+		}
+
+		t := time.NewTimer(o.interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// drain checks observations until the channel is closed, check returns true
+// or an error, or the context is done.
+func drain(ctx context.Context, ch <-chan Observation, check func(Observation) (bool, error)) (bool, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case obs, ok := <-ch:
+			if !ok {
+				return false, nil
+			}
+			if ok, err := check(obs); ok || err != nil {
+				return ok, err
+			}
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 Upbound Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+// polled is an Object that returns its observations in order, repeating the
+// last one.
+type polled struct {
+	obs []Observation
+	err error
+}
+
+func (p *polled) Observe(_ context.Context) (Observation, error) {
+	if p.err != nil {
+		return Observation{}, p.err
+	}
+	o := p.obs[0]
+	if len(p.obs) > 1 {
+		p.obs = p.obs[1:]
+	}
+	return o, nil
+}
+
+// watched is a Watcher that streams its observations once. Further watches
+// fail.
+type watched struct {
+	polled
+	events  []Observation
+	watches int
+}
+
+func (w *watched) Watch(_ context.Context) (<-chan Observation, error) {
+	w.watches++
+	if w.watches > 1 {
+		return nil, errors.New("boom")
+	}
+	ch := make(chan Observation, len(w.events))
+	for _, e := range w.events {
+		ch <- e
+	}
+	close(ch)
+	return ch, nil
+}
+
+func ready(s corev1.ConditionStatus) Observation {
+	return Observation{Conditions: []xpv1.Condition{{Type: xpv1.TypeReady, Status: s}}}
+}
+
+func TestWaitForReady(t *testing.T) {
+	errBoom := errors.New("boom")
+	type want struct {
+		err      error
+		progress int
+	}
+	cases := map[string]struct {
+		reason string
+		obj    Object
+		opts   []Option
+		want   want
+	}{
+		"AlreadyReady": {
+			reason: "An object that is already ready should be observed once.",
+			obj:    &polled{obs: []Observation{ready(corev1.ConditionTrue)}},
+			want:   want{progress: 1},
+		},
+		"Polled": {
+			reason: "An object should be polled until it is ready.",
+			obj:    &polled{obs: []Observation{{}, ready(corev1.ConditionFalse), ready(corev1.ConditionTrue)}},
+			want:   want{progress: 3},
+		},
+		"Watched": {
+			reason: "Watch events should be observed until the object is ready.",
+			obj: &watched{
+				polled: polled{obs: []Observation{ready(corev1.ConditionFalse)}},
+				events: []Observation{ready(corev1.ConditionFalse), ready(corev1.ConditionTrue)},
+			},
+			want: want{progress: 3},
+		},
+		"WatchEnded": {
+			reason: "The object should be polled if its watch ends and can't be restarted.",
+			obj: &watched{
+				polled: polled{obs: []Observation{ready(corev1.ConditionFalse), ready(corev1.ConditionTrue)}},
+			},
+			want: want{progress: 2},
+		},
+		"Deleted": {
+			reason: "An object deleted while waiting should return an error.",
+			obj:    &polled{obs: []Observation{{}, {Deleted: true}}},
+			want: want{
+				err:      errors.Wrapf(errors.Errorf(errFmtDeleted, xpv1.TypeReady, corev1.ConditionTrue), errFmtWaitCondition, xpv1.TypeReady, corev1.ConditionTrue),
+				progress: 2,
+			},
+		},
+		"ObserveError": {
+			reason: "Errors observing the object should be returned.",
+			obj:    &polled{err: errBoom},
+			want:   want{err: errors.Wrapf(errBoom, errFmtWaitCondition, xpv1.TypeReady, corev1.ConditionTrue)},
+		},
+		"Timeout": {
+			reason: "Waiting should stop once the timeout expires.",
+			obj:    &polled{obs: []Observation{ready(corev1.ConditionFalse)}},
+			opts:   []Option{WithTimeout(10 * time.Millisecond), WithInterval(time.Hour)},
+			want: want{
+				err:      errors.Wrapf(context.DeadlineExceeded, errFmtWaitCondition, xpv1.TypeReady, corev1.ConditionTrue),
+				progress: 1,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			progress := 0
+			opts := append([]Option{WithInterval(time.Millisecond), WithProgress(func(Observation) { progress++ })}, tc.opts...)
+			err := WaitForReady(context.Background(), tc.obj, opts...)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nWaitForReady(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.progress, progress); diff != "" {
+				t.Errorf("\n%s\nWaitForReady(...): -want progress, +got progress:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWaitForDeleted(t *testing.T) {
+	obj := &watched{
+		polled: polled{obs: []Observation{ready(corev1.ConditionTrue)}},
+		events: []Observation{ready(corev1.ConditionFalse), {Deleted: true}},
+	}
+	if err := WaitForDeleted(context.Background(), obj); err != nil {
+		t.Errorf("\n%s\nWaitForDeleted(...): %v", "A deletion event should end the wait.", err)
+	}
+}
+
+func TestWaitForCondition(t *testing.T) {
+	synced := Observation{Conditions: []xpv1.Condition{{Type: xpv1.TypeSynced, Status: corev1.ConditionFalse}}}
+	obj := &polled{obs: []Observation{{}, synced}}
+	if err := WaitForCondition(context.Background(), obj, xpv1.TypeSynced, corev1.ConditionFalse, WithInterval(time.Millisecond)); err != nil {
+		t.Errorf("\n%s\nWaitForCondition(...): %v", "Waiting should end once the condition has the desired status.", err)
+	}
+}